@@ -0,0 +1,173 @@
+package wavebin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// ltxtFixedSize is the size of the ltxt chunk body without the text.
+const ltxtFixedSize = 20
+
+// CueLabel is a labl chunk to give a label to the cue point.
+type CueLabel struct {
+	CuePointID uint32
+	Text       string
+}
+
+// CueNote is a note chunk to give a comment to the cue point.
+type CueNote struct {
+	CuePointID uint32
+	Text       string
+}
+
+// CueLabeledText is a ltxt chunk to give a text to the region from the cue point.
+type CueLabeledText struct {
+	CuePointID   uint32
+	SampleLength uint32
+	Purpose      [4]byte // e.g. "rgn "
+	Country      uint16
+	Language     uint16
+	Dialect      uint16
+	CodePage     uint16
+	Text         string
+}
+
+// AssociatedDataListChunk is a LIST chunk of adtl type to associate the texts with the cue points in the cue chunk.
+// It is written in the order of Order, and the rest are written in the order of labl, note, ltxt and the unknown chunks.
+type AssociatedDataListChunk struct {
+	Labels       []CueLabel
+	Notes        []CueNote
+	LabeledTexts []CueLabeledText
+
+	// Unknown is the unknown sub-chunks such as file chunks. They are kept only if the unknown chunks are ignored on parsing.
+	Unknown []ChunkProvider
+
+	// Order is the order of the sub-chunk IDs to write, and it's set on parsing to keep the original order.
+	// Each ID takes the next one of Labels, Notes or LabeledTexts, and the other IDs take the next one of Unknown.
+	Order [][4]byte
+}
+
+func (c *AssociatedDataListChunk) Chunk() riffbin.Chunk {
+	payload := make([]riffbin.Chunk, 0, len(c.Labels)+len(c.Notes)+len(c.LabeledTexts)+len(c.Unknown))
+	var labels, notes, labeledTexts, unknown int
+	for _, id := range c.Order {
+		switch {
+		case id == lablBytes && labels < len(c.Labels):
+			payload = append(payload, cueLabelChunk(c.Labels[labels]))
+			labels++
+		case id == noteBytes && notes < len(c.Notes):
+			payload = append(payload, cueNoteChunk(c.Notes[notes]))
+			notes++
+		case id == ltxtBytes && labeledTexts < len(c.LabeledTexts):
+			payload = append(payload, cueLabeledTextChunk(c.LabeledTexts[labeledTexts]))
+			labeledTexts++
+		case id != lablBytes && id != noteBytes && id != ltxtBytes && unknown < len(c.Unknown):
+			payload = append(payload, c.Unknown[unknown].Chunk())
+			unknown++
+		}
+	}
+
+	for _, l := range c.Labels[labels:] {
+		payload = append(payload, cueLabelChunk(l))
+	}
+	for _, n := range c.Notes[notes:] {
+		payload = append(payload, cueNoteChunk(n))
+	}
+	for _, t := range c.LabeledTexts[labeledTexts:] {
+		payload = append(payload, cueLabeledTextChunk(t))
+	}
+	for _, u := range c.Unknown[unknown:] {
+		payload = append(payload, u.Chunk())
+	}
+
+	return &riffbin.ListChunk{
+		ListType: adtlBytes,
+		Payload:  payload,
+	}
+}
+
+func cueLabelChunk(l CueLabel) riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      lablBytes,
+		Payload: cueTextBytes(l.CuePointID, l.Text),
+	}
+}
+
+func cueNoteChunk(n CueNote) riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      noteBytes,
+		Payload: cueTextBytes(n.CuePointID, n.Text),
+	}
+}
+
+func cueLabeledTextChunk(t CueLabeledText) riffbin.Chunk {
+	b := make([]byte, ltxtFixedSize, ltxtFixedSize+len(t.Text)+1)
+	binary.LittleEndian.PutUint32(b[0:4], t.CuePointID)
+	binary.LittleEndian.PutUint32(b[4:8], t.SampleLength)
+	copy(b[8:12], t.Purpose[:])
+	binary.LittleEndian.PutUint16(b[12:14], t.Country)
+	binary.LittleEndian.PutUint16(b[14:16], t.Language)
+	binary.LittleEndian.PutUint16(b[16:18], t.Dialect)
+	binary.LittleEndian.PutUint16(b[18:20], t.CodePage)
+	if t.Text != "" {
+		b = append(b, t.Text...)
+		b = append(b, 0)
+	}
+
+	return &riffbin.OnMemorySubChunk{
+		ID:      ltxtBytes,
+		Payload: b,
+	}
+}
+
+// cueTextBytes returns the body of labl or note chunk. The text is NUL-terminated.
+func cueTextBytes(cuePointID uint32, text string) []byte {
+	b := make([]byte, 4, 4+len(text)+1)
+	binary.LittleEndian.PutUint32(b, cuePointID)
+	b = append(b, text...)
+	return append(b, 0)
+}
+
+func readCueText(r io.Reader) (cuePointID uint32, text string, err error) {
+	var b bytes.Buffer
+	_, err = io.Copy(&b, r)
+	if err != nil {
+		return
+	}
+	if b.Len() < 4 {
+		err = io.ErrUnexpectedEOF
+		return
+	}
+
+	cuePointID = binary.LittleEndian.Uint32(b.Next(4))
+	text = string(bytes.TrimRight(b.Bytes(), "\x00"))
+	return
+}
+
+func readCueLabeledText(r io.Reader) (t CueLabeledText, err error) {
+	var b bytes.Buffer
+	_, err = io.Copy(&b, r)
+	if err != nil {
+		return
+	}
+	if b.Len() < ltxtFixedSize {
+		err = io.ErrUnexpectedEOF
+		return
+	}
+
+	fb := b.Next(ltxtFixedSize)
+	t = CueLabeledText{
+		CuePointID:   binary.LittleEndian.Uint32(fb[0:4]),
+		SampleLength: binary.LittleEndian.Uint32(fb[4:8]),
+		Country:      binary.LittleEndian.Uint16(fb[12:14]),
+		Language:     binary.LittleEndian.Uint16(fb[14:16]),
+		Dialect:      binary.LittleEndian.Uint16(fb[16:18]),
+		CodePage:     binary.LittleEndian.Uint16(fb[18:20]),
+		Text:         string(bytes.TrimRight(b.Bytes(), "\x00")),
+	}
+	copy(t.Purpose[:], fb[8:12])
+	return
+}
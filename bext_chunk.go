@@ -0,0 +1,115 @@
+package wavebin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// bextFixedSize is the size of the bext chunk body without CodingHistory.
+const bextFixedSize = 602
+
+// BextChunk is a bext chunk of Broadcast Wave Format (EBU Tech 3285).
+// The text fields are ASCII and truncated to the fixed width of the field on writing.
+type BextChunk struct {
+	Description         string // up to 256 bytes
+	Originator          string // up to 32 bytes
+	OriginatorReference string // up to 32 bytes
+	OriginationDate     string // yyyy-mm-dd
+	OriginationTime     string // hh:mm:ss
+	TimeReference       uint64 // sample count since midnight
+
+	// Version is the BWF version. UMID is available since version 1 and the loudness values since version 2.
+	Version uint16
+	UMID    [64]byte
+
+	// Loudness values are 100 times of the values in LUFS/LU/dBTP.
+	LoudnessValue        int16
+	LoudnessRange        int16
+	MaxTruePeakLevel     int16
+	MaxMomentaryLoudness int16
+	MaxShortTermLoudness int16
+
+	CodingHistory string
+}
+
+func (c *BextChunk) Bytes() (b []byte) {
+	b = make([]byte, bextFixedSize+len(c.CodingHistory))
+
+	copy(b[0:256], c.Description)
+	copy(b[256:288], c.Originator)
+	copy(b[288:320], c.OriginatorReference)
+	copy(b[320:330], c.OriginationDate)
+	copy(b[330:338], c.OriginationTime)
+	binary.LittleEndian.PutUint32(b[338:342], uint32(c.TimeReference))
+	binary.LittleEndian.PutUint32(b[342:346], uint32(c.TimeReference>>32))
+	binary.LittleEndian.PutUint16(b[346:348], c.Version)
+	if c.Version >= 1 {
+		copy(b[348:412], c.UMID[:])
+	}
+	if c.Version >= 2 {
+		binary.LittleEndian.PutUint16(b[412:414], uint16(c.LoudnessValue))
+		binary.LittleEndian.PutUint16(b[414:416], uint16(c.LoudnessRange))
+		binary.LittleEndian.PutUint16(b[416:418], uint16(c.MaxTruePeakLevel))
+		binary.LittleEndian.PutUint16(b[418:420], uint16(c.MaxMomentaryLoudness))
+		binary.LittleEndian.PutUint16(b[420:422], uint16(c.MaxShortTermLoudness))
+	}
+	// 422:602 is reserved
+	copy(b[bextFixedSize:], c.CodingHistory)
+
+	return
+}
+
+func (c *BextChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      bextBytes,
+		Payload: c.Bytes(),
+	}
+}
+
+func (c *BextChunk) ReadFrom(r io.Reader) (int64, error) {
+	var b [bextFixedSize]byte
+	n, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return int64(n), err
+	}
+
+	var codingHistory bytes.Buffer
+	nn, err := io.Copy(&codingHistory, r)
+	if err != nil {
+		return int64(n) + nn, err
+	}
+
+	*c = BextChunk{
+		Description:         fixedString(b[0:256]),
+		Originator:          fixedString(b[256:288]),
+		OriginatorReference: fixedString(b[288:320]),
+		OriginationDate:     fixedString(b[320:330]),
+		OriginationTime:     fixedString(b[330:338]),
+		TimeReference:       uint64(binary.LittleEndian.Uint32(b[338:342])) | uint64(binary.LittleEndian.Uint32(b[342:346]))<<32,
+		Version:             binary.LittleEndian.Uint16(b[346:348]),
+		CodingHistory:       fixedString(codingHistory.Bytes()),
+	}
+	if c.Version >= 1 {
+		copy(c.UMID[:], b[348:412])
+	}
+	if c.Version >= 2 {
+		c.LoudnessValue = int16(binary.LittleEndian.Uint16(b[412:414]))
+		c.LoudnessRange = int16(binary.LittleEndian.Uint16(b[414:416]))
+		c.MaxTruePeakLevel = int16(binary.LittleEndian.Uint16(b[416:418]))
+		c.MaxMomentaryLoudness = int16(binary.LittleEndian.Uint16(b[418:420]))
+		c.MaxShortTermLoudness = int16(binary.LittleEndian.Uint16(b[420:422]))
+	}
+
+	return int64(n) + nn, nil
+}
+
+// fixedString returns the string of the NUL-padded fixed width field.
+func fixedString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i != -1 {
+		b = b[:i]
+	}
+	return string(b)
+}
@@ -0,0 +1,118 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestBextChunk(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		chunk    *wavebin.BextChunk
+		expected *wavebin.BextChunk
+	}{
+		{
+			name: "Version2",
+			chunk: &wavebin.BextChunk{
+				Description:          "description",
+				Originator:           "wavebin",
+				OriginatorReference:  "REF0001",
+				OriginationDate:      "2022-04-01",
+				OriginationTime:      "12:34:56",
+				TimeReference:        0x123456789A,
+				Version:              2,
+				UMID:                 [64]byte{0x06, 0x0A, 0x2B, 0x34},
+				LoudnessValue:        -2300,
+				LoudnessRange:        500,
+				MaxTruePeakLevel:     -100,
+				MaxMomentaryLoudness: -1800,
+				MaxShortTermLoudness: -2000,
+				CodingHistory:        "A=PCM,F=48000,W=24,M=stereo,T=wavebin\r\n",
+			},
+			expected: &wavebin.BextChunk{
+				Description:          "description",
+				Originator:           "wavebin",
+				OriginatorReference:  "REF0001",
+				OriginationDate:      "2022-04-01",
+				OriginationTime:      "12:34:56",
+				TimeReference:        0x123456789A,
+				Version:              2,
+				UMID:                 [64]byte{0x06, 0x0A, 0x2B, 0x34},
+				LoudnessValue:        -2300,
+				LoudnessRange:        500,
+				MaxTruePeakLevel:     -100,
+				MaxMomentaryLoudness: -1800,
+				MaxShortTermLoudness: -2000,
+				CodingHistory:        "A=PCM,F=48000,W=24,M=stereo,T=wavebin\r\n",
+			},
+		},
+		{
+			name: "Version1",
+			chunk: &wavebin.BextChunk{
+				Description:   "description",
+				Version:       1,
+				UMID:          [64]byte{0x06, 0x0A, 0x2B, 0x34},
+				LoudnessValue: -2300,
+			},
+			expected: &wavebin.BextChunk{
+				Description: "description",
+				Version:     1,
+				UMID:        [64]byte{0x06, 0x0A, 0x2B, 0x34},
+			},
+		},
+		{
+			name: "Version0",
+			chunk: &wavebin.BextChunk{
+				Description: "description",
+				UMID:        [64]byte{0x06, 0x0A, 0x2B, 0x34},
+			},
+			expected: &wavebin.BextChunk{
+				Description: "description",
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := tt.chunk.Chunk().(*riffbin.OnMemorySubChunk)
+			if string(chunk.ChunkID()) != "bext" {
+				t.Errorf("unexpected chunk ID: %s", string(chunk.ChunkID()))
+			}
+			if chunk.BodySize() != uint32(602+len(tt.chunk.CodingHistory)) {
+				t.Errorf("unexpected body size: %d", chunk.BodySize())
+			}
+			if v := binary.LittleEndian.Uint16(chunk.Payload[346:348]); v != tt.chunk.Version {
+				t.Errorf("unexpected version: %d", v)
+			}
+
+			var got wavebin.BextChunk
+			_, err := got.ReadFrom(bytes.NewReader(chunk.Payload))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if df := cmp.Diff(tt.expected, &got); df != "" {
+				t.Errorf("bext diff: %s", df)
+			}
+		})
+	}
+
+	t.Run("TruncateLongText", func(t *testing.T) {
+		chunk := (&wavebin.BextChunk{Originator: string(bytes.Repeat([]byte{'a'}, 40))}).Chunk().(*riffbin.OnMemorySubChunk)
+
+		var got wavebin.BextChunk
+		_, err := got.ReadFrom(bytes.NewReader(chunk.Payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Originator != string(bytes.Repeat([]byte{'a'}, 32)) {
+			t.Errorf("unexpected originator: %s", got.Originator)
+		}
+		if got.OriginatorReference != "" {
+			t.Errorf("unexpected originator reference: %s", got.OriginatorReference)
+		}
+	})
+}
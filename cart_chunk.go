@@ -0,0 +1,137 @@
+package wavebin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// cartFixedSize is the size of the cart chunk body without TagText.
+const cartFixedSize = 2048
+
+// CartVersion is the version of AES46-2002. It's written if CartChunk.Version is empty.
+const CartVersion = "0101"
+
+// CartChunk is a cart chunk of the broadcast cart labels (AES46).
+// The text fields are ASCII and truncated to the fixed width of the field on writing.
+// They are NUL-padded on writing, and both NUL and space padding are trimmed on reading.
+type CartChunk struct {
+	Version            string // 4 bytes, e.g. "0101"
+	Title              string // up to 64 bytes
+	Artist             string // up to 64 bytes
+	CutID              string // up to 64 bytes
+	ClientID           string // up to 64 bytes
+	Category           string // up to 64 bytes
+	Classification     string // up to 64 bytes
+	OutCue             string // up to 64 bytes
+	StartDate          string // yyyy/mm/dd
+	StartTime          string // hh:mm:ss
+	EndDate            string // yyyy/mm/dd
+	EndTime            string // hh:mm:ss
+	ProducerAppID      string // up to 64 bytes
+	ProducerAppVersion string // up to 64 bytes
+	UserDef            string // up to 64 bytes
+	LevelReference     int32  // sample value for 0 dB reference
+	PostTimers         [8]CartPostTimer
+	URL                string // up to 1024 bytes
+
+	TagText string
+}
+
+// CartPostTimer is a post timer of the cart chunk. Usage is a FOURCC such as "SEC1" or "EOD ", and it's zero if the timer is unused.
+type CartPostTimer struct {
+	Usage [4]byte
+	Value uint32 // sample offset from the beginning of the data
+}
+
+func (c *CartChunk) Bytes() (b []byte) {
+	b = make([]byte, cartFixedSize+len(c.TagText))
+
+	version := c.Version
+	if version == "" {
+		version = CartVersion
+	}
+	copy(b[0:4], version)
+	copy(b[4:68], c.Title)
+	copy(b[68:132], c.Artist)
+	copy(b[132:196], c.CutID)
+	copy(b[196:260], c.ClientID)
+	copy(b[260:324], c.Category)
+	copy(b[324:388], c.Classification)
+	copy(b[388:452], c.OutCue)
+	copy(b[452:462], c.StartDate)
+	copy(b[462:470], c.StartTime)
+	copy(b[470:480], c.EndDate)
+	copy(b[480:488], c.EndTime)
+	copy(b[488:552], c.ProducerAppID)
+	copy(b[552:616], c.ProducerAppVersion)
+	copy(b[616:680], c.UserDef)
+	binary.LittleEndian.PutUint32(b[680:684], uint32(c.LevelReference))
+	for i, timer := range c.PostTimers {
+		offset := 684 + i*8
+		copy(b[offset:offset+4], timer.Usage[:])
+		binary.LittleEndian.PutUint32(b[offset+4:offset+8], timer.Value)
+	}
+	// 748:1024 is reserved
+	copy(b[1024:2048], c.URL)
+	copy(b[cartFixedSize:], c.TagText)
+
+	return
+}
+
+func (c *CartChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      cartBytes,
+		Payload: c.Bytes(),
+	}
+}
+
+func (c *CartChunk) ReadFrom(r io.Reader) (int64, error) {
+	var b [cartFixedSize]byte
+	n, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return int64(n), err
+	}
+
+	var tagText bytes.Buffer
+	nn, err := io.Copy(&tagText, r)
+	if err != nil {
+		return int64(n) + nn, err
+	}
+
+	*c = CartChunk{
+		Version:            cartString(b[0:4]),
+		Title:              cartString(b[4:68]),
+		Artist:             cartString(b[68:132]),
+		CutID:              cartString(b[132:196]),
+		ClientID:           cartString(b[196:260]),
+		Category:           cartString(b[260:324]),
+		Classification:     cartString(b[324:388]),
+		OutCue:             cartString(b[388:452]),
+		StartDate:          cartString(b[452:462]),
+		StartTime:          cartString(b[462:470]),
+		EndDate:            cartString(b[470:480]),
+		EndTime:            cartString(b[480:488]),
+		ProducerAppID:      cartString(b[488:552]),
+		ProducerAppVersion: cartString(b[552:616]),
+		UserDef:            cartString(b[616:680]),
+		LevelReference:     int32(binary.LittleEndian.Uint32(b[680:684])),
+		URL:                cartString(b[1024:2048]),
+		TagText:            fixedString(tagText.Bytes()),
+	}
+	for i := range c.PostTimers {
+		offset := 684 + i*8
+		copy(c.PostTimers[i].Usage[:], b[offset:offset+4])
+		c.PostTimers[i].Value = binary.LittleEndian.Uint32(b[offset+4 : offset+8])
+	}
+
+	return int64(n) + nn, nil
+}
+
+// cartString returns the string of the NUL- or space-padded fixed width field.
+func cartString(b []byte) string {
+	return strings.TrimRight(fixedString(b), " ")
+}
@@ -0,0 +1,145 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestCartChunk(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		chunk    *wavebin.CartChunk
+		expected *wavebin.CartChunk
+	}{
+		{
+			name: "Full",
+			chunk: &wavebin.CartChunk{
+				Version:            "0101",
+				Title:              "Title",
+				Artist:             "Artist",
+				CutID:              "CUT001",
+				ClientID:           "CLIENT",
+				Category:           "MUSIC",
+				Classification:     "Pop",
+				OutCue:             "fade",
+				StartDate:          "2022/04/01",
+				StartTime:          "00:00:00",
+				EndDate:            "2022/12/31",
+				EndTime:            "23:59:59",
+				ProducerAppID:      "wavebin",
+				ProducerAppVersion: "1.0",
+				UserDef:            "user",
+				LevelReference:     -32768,
+				PostTimers: [8]wavebin.CartPostTimer{
+					{Usage: [4]byte{'S', 'E', 'C', '1'}, Value: 44100},
+					{Usage: [4]byte{'E', 'O', 'D', ' '}, Value: 441000},
+				},
+				URL:     "https://example.com/",
+				TagText: "tag\r\n",
+			},
+		},
+		{
+			name: "OddTagText",
+			chunk: &wavebin.CartChunk{
+				Version: "0101",
+				TagText: "abc",
+			},
+		},
+		{
+			name:  "DefaultVersion",
+			chunk: &wavebin.CartChunk{Title: "Title"},
+			expected: &wavebin.CartChunk{
+				Version: wavebin.CartVersion,
+				Title:   "Title",
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			expected := tt.expected
+			if expected == nil {
+				expected = tt.chunk
+			}
+
+			chunk := tt.chunk.Chunk().(*riffbin.OnMemorySubChunk)
+			if string(chunk.ChunkID()) != "cart" {
+				t.Errorf("unexpected chunk ID: %s", string(chunk.ChunkID()))
+			}
+			if chunk.BodySize() != uint32(2048+len(tt.chunk.TagText)) {
+				t.Errorf("unexpected body size: %d", chunk.BodySize())
+			}
+
+			var got wavebin.CartChunk
+			_, err := got.ReadFrom(bytes.NewReader(chunk.Payload))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if df := cmp.Diff(expected, &got); df != "" {
+				t.Errorf("cart diff: %s", df)
+			}
+		})
+	}
+
+	t.Run("Padding", func(t *testing.T) {
+		payload := (&wavebin.CartChunk{}).Bytes()
+		copy(payload[4:68], bytes.Repeat([]byte{' '}, 64))
+		copy(payload[4:], "Title")
+		copy(payload[68:132], "Artist\x00  ")
+
+		var got wavebin.CartChunk
+		_, err := got.ReadFrom(bytes.NewReader(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Title != "Title" {
+			t.Errorf("unexpected title: %q", got.Title)
+		}
+		if got.Artist != "Artist" {
+			t.Errorf("unexpected artist: %q", got.Artist)
+		}
+		if !bytes.Equal(payload[132:196], make([]byte, 64)) {
+			t.Errorf("should be NUL-padded: %q", payload[132:196])
+		}
+	})
+
+	t.Run("TruncateLongText", func(t *testing.T) {
+		chunk := (&wavebin.CartChunk{Title: string(bytes.Repeat([]byte{'a'}, 80))}).Chunk().(*riffbin.OnMemorySubChunk)
+
+		var got wavebin.CartChunk
+		_, err := got.ReadFrom(bytes.NewReader(chunk.Payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Title != string(bytes.Repeat([]byte{'a'}, 64)) {
+			t.Errorf("unexpected title: %s", got.Title)
+		}
+		if got.Artist != "" {
+			t.Errorf("unexpected artist: %s", got.Artist)
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		format := &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		}
+		cart := &wavebin.CartChunk{Version: "0101", Title: "Title", CutID: "CUT001"}
+		chunks, err := wavebin.ParseWaveChunks(wavebin.CreateCompletedRIFF(format, []byte{0x80}, cart), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(cart, chunks.Cart); diff != "" {
+			t.Errorf("unexpected cart chunk: %s", diff)
+		}
+
+		short := &wavebin.RawChunk{ID: [4]byte{'c', 'a', 'r', 't'}, Payload: make([]byte, 100)}
+		_, err = wavebin.ParseWaveChunks(wavebin.CreateCompletedRIFF(format, []byte{0x80}, short), false)
+		if !errors.Is(err, wavebin.ErrUnexpectedChunkSize) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package wavebin
+
+import (
+	"sync"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// ChunkRegistry is the set of ChunkDecoders for the custom chunks by the chunk ID or the LIST type.
+// The decoders are used only for the chunks which are not built-in, so they cannot replace the built-in decoders.
+// It's safe for concurrent use.
+type ChunkRegistry struct {
+	mu     sync.RWMutex
+	chunks map[[4]byte]ChunkDecoder
+	lists  map[[4]byte]ChunkDecoder
+}
+
+// DefaultChunkRegistry is the registry used by the parser if ParseOptions.Registry is nil.
+var DefaultChunkRegistry = NewChunkRegistry()
+
+func NewChunkRegistry() *ChunkRegistry {
+	return &ChunkRegistry{
+		chunks: map[[4]byte]ChunkDecoder{},
+		lists:  map[[4]byte]ChunkDecoder{},
+	}
+}
+
+// RegisterChunk registers the decoder for the sub-chunks of the chunk ID. It replaces the decoder registered before.
+func (r *ChunkRegistry) RegisterChunk(id [4]byte, decoder ChunkDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.chunks[id] = decoder
+}
+
+// RegisterList registers the decoder for the LIST chunks of the list type. It replaces the decoder registered before.
+func (r *ChunkRegistry) RegisterList(listType [4]byte, decoder ChunkDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lists[listType] = decoder
+}
+
+func (r *ChunkRegistry) lookup(chunk riffbin.Chunk) (ChunkDecoder, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if listChunk, ok := chunk.(*riffbin.ListChunk); ok {
+		decoder, ok := r.lists[listChunk.ListType]
+		return decoder, ok
+	}
+
+	var id [4]byte
+	copy(id[:], chunk.ChunkID())
+	decoder, ok := r.chunks[id]
+	return decoder, ok
+}
+
+// RegisterChunk registers the decoder for the sub-chunks of the chunk ID to DefaultChunkRegistry.
+func RegisterChunk(id [4]byte, decoder ChunkDecoder) {
+	DefaultChunkRegistry.RegisterChunk(id, decoder)
+}
+
+// RegisterList registers the decoder for the LIST chunks of the list type to DefaultChunkRegistry.
+func RegisterList(listType [4]byte, decoder ChunkDecoder) {
+	DefaultChunkRegistry.RegisterList(listType, decoder)
+}
@@ -0,0 +1,122 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+type counterListChunk struct {
+	Counts []uint8
+}
+
+func (c *counterListChunk) Chunk() riffbin.Chunk {
+	payload := make([]riffbin.Chunk, len(c.Counts))
+	for i, count := range c.Counts {
+		payload[i] = &riffbin.OnMemorySubChunk{ID: [4]byte{'c', 'n', 't', ' '}, Payload: []byte{count}}
+	}
+	return &riffbin.ListChunk{ListType: [4]byte{'c', 'n', 't', 'r'}, Payload: payload}
+}
+
+func decodeCounterListChunk(chunk riffbin.Chunk) (wavebin.ChunkProvider, error) {
+	c := &counterListChunk{}
+	for _, p := range chunk.(*riffbin.ListChunk).Payload {
+		b, err := io.ReadAll(p.(riffbin.SubChunk))
+		if err != nil {
+			return nil, err
+		}
+		c.Counts = append(c.Counts, b...)
+	}
+	return c, nil
+}
+
+func TestChunkRegistry(t *testing.T) {
+	wavebin.RegisterList([4]byte{'c', 'n', 't', 'r'}, decodeCounterListChunk)
+
+	registry := wavebin.NewChunkRegistry()
+	registry.RegisterChunk([4]byte{'v', 'n', 'd', 'r'}, decodeVendorChunk)
+
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+	}
+	extras := []wavebin.ChunkProvider{
+		&vendorChunk{Value: "vendor"},
+		&counterListChunk{Counts: []uint8{1, 2, 3}},
+		&wavebin.BextChunk{Description: "description"},
+	}
+
+	var src bytes.Buffer
+	_, err := riffbin.NewCompletedChunkWriter(&src).Write(wavebin.CreateCompletedRIFF(format, []byte{0x00, 0x01}, extras...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readRIFF := func(t *testing.T) *riffbin.RIFFChunk {
+		t.Helper()
+
+		riffChunk, err := wavebin.ReadWaveRIFF(bytes.NewReader(src.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return riffChunk
+	}
+
+	t.Run("DefaultRegistry", func(t *testing.T) {
+		_, err := wavebin.ParseWaveChunks(readRIFF(t), false)
+		if err == nil {
+			t.Error("should be error for the vendor chunk")
+		}
+	})
+
+	t.Run("CustomRegistry", func(t *testing.T) {
+		_, err := wavebin.ParseWaveChunksWithOptions(readRIFF(t), wavebin.ParseOptions{Registry: registry})
+		if err == nil {
+			t.Error("should be error for the counter list chunk")
+		}
+
+		registry.RegisterList([4]byte{'c', 'n', 't', 'r'}, decodeCounterListChunk)
+		chunks, err := wavebin.ParseWaveChunksWithOptions(readRIFF(t), wavebin.ParseOptions{Registry: registry})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if df := cmp.Diff(extras[:2], chunks.Custom); df != "" {
+			t.Errorf("custom diff: %s", df)
+		}
+		if df := cmp.Diff(extras, chunks.Extras); df != "" {
+			t.Errorf("extras diff: %s", df)
+		}
+
+		var dst bytes.Buffer
+		_, err = riffbin.NewCompletedChunkWriter(&dst).Write(wavebin.CreateCompletedRIFF(chunks.Format, []byte{0x00, 0x01}, chunks.Extras...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(src.Bytes(), dst.Bytes()) {
+			t.Errorf("round-trip mismatch:\n%x\n%x", src.Bytes(), dst.Bytes())
+		}
+	})
+	t.Run("BuiltinChunk", func(t *testing.T) {
+		registry := wavebin.NewChunkRegistry()
+		registry.RegisterChunk([4]byte{'v', 'n', 'd', 'r'}, decodeVendorChunk)
+		registry.RegisterList([4]byte{'c', 'n', 't', 'r'}, decodeCounterListChunk)
+		registry.RegisterChunk([4]byte{'b', 'e', 'x', 't'}, func(chunk riffbin.Chunk) (wavebin.ChunkProvider, error) {
+			t.Error("should not be called for the built-in chunk")
+			return nil, nil
+		})
+
+		chunks, err := wavebin.ParseWaveChunksWithOptions(readRIFF(t), wavebin.ParseOptions{Registry: registry})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if df := cmp.Diff(extras[2], chunks.Bext); df != "" {
+			t.Errorf("bext diff: %s", df)
+		}
+		if df := cmp.Diff(extras[:2], chunks.Custom); df != "" {
+			t.Errorf("custom diff: %s", df)
+		}
+	})
+}
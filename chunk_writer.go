@@ -0,0 +1,137 @@
+package wavebin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// writeChunk writes a sub-chunk or a LIST chunk as well as riffbin.CompletedChunkWriter does for the RIFF chunk,
+// but it writes a pad byte after the odd-sized body to keep the next chunk word-aligned as the RIFF specification requires.
+// The pad byte is not included in the chunk size, but it's included in the size of the parent LIST chunk.
+func writeChunk(w io.Writer, c riffbin.Chunk) (n int64, err error) {
+	n, err = writeChunkHeader(w, c.ChunkID(), chunkBodySize(c))
+	if err != nil {
+		return
+	}
+
+	switch cc := c.(type) {
+	case *riffbin.ListChunk:
+		var nn int
+		nn, err = w.Write(cc.ListType[:])
+		n += int64(nn)
+		if err != nil {
+			err = fmt.Errorf("chunk[%q] type: %w", string(c.ChunkID()), err)
+			return
+		}
+
+		for i, p := range cc.Payload {
+			var nnn int64
+			nnn, err = writeChunk(w, p)
+			n += nnn
+			if err != nil {
+				err = fmt.Errorf("chunk[%q] payload[%d]: %w", string(c.ChunkID()), i, err)
+				return
+			}
+		}
+	case riffbin.SubChunk:
+		if cc.Incomplete() {
+			err = fmt.Errorf("chunk[%q] body: %w", string(c.ChunkID()), riffbin.ErrUnexpectedIncompleteChunk)
+			return
+		}
+
+		var nn int64
+		nn, err = io.Copy(w, cc)
+		n += nn
+		if err != nil {
+			err = fmt.Errorf("chunk[%q] body: %w", string(c.ChunkID()), err)
+			return
+		}
+	default:
+		panic(fmt.Sprintf("unknown chunk type: %+v", c))
+	}
+
+	if chunkBodySize(c)%2 != 0 {
+		var nn int
+		nn, err = w.Write([]byte{0})
+		n += int64(nn)
+		if err != nil {
+			err = fmt.Errorf("chunk[%q] pad byte: %w", string(c.ChunkID()), err)
+			return
+		}
+	}
+
+	return
+}
+
+// chunkBodySize returns the size of the chunk body written by writeChunk.
+// It differs from BodySize of *riffbin.ListChunk if the LIST chunk has odd-sized sub-chunks because of the pad bytes.
+func chunkBodySize(c riffbin.Chunk) uint32 {
+	l, ok := c.(*riffbin.ListChunk)
+	if !ok {
+		return c.BodySize()
+	}
+
+	size := uint32(len(l.ListType))
+	for _, p := range l.Payload {
+		size += riffbin.HeaderBytes + paddedSize(chunkBodySize(p))
+	}
+	return size
+}
+
+// paddedSize returns the size with the pad byte for the odd size.
+func paddedSize(size uint32) uint32 {
+	return size + size%2
+}
+
+func writeChunkHeader(w io.Writer, id []byte, size uint32) (int64, error) {
+	var b [riffbin.HeaderBytes]byte
+	copy(b[:4], id)
+	binary.LittleEndian.PutUint32(b[4:], size)
+
+	n, err := w.Write(b[:])
+	if err != nil {
+		return int64(n), fmt.Errorf("chunk[%q] header: %w", string(id), err)
+	}
+
+	return int64(n), nil
+}
+
+// writeUint32At writes the little endian uint32 at the absolute offset and keeps the current seek position.
+func writeUint32At(w io.WriteSeeker, off int64, v uint32) error {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	return writeBytesAt(w, off, b[:])
+}
+
+// writeBytesAt writes the bytes at the absolute offset and keeps the current seek position.
+func writeBytesAt(w io.WriteSeeker, off int64, b []byte) error {
+	if wa, ok := w.(io.WriterAt); ok {
+		_, err := wa.WriteAt(b, off)
+		return err
+	}
+
+	cur, err := w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+
+	_, err = w.Seek(off, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+
+	_, err = w.Write(b)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Seek(cur, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+
+	return nil
+}
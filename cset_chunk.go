@@ -0,0 +1,169 @@
+package wavebin
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// csetSize is the size of the CSET chunk body.
+const csetSize = 8
+
+// Code pages for CharacterSetChunk.
+const (
+	CodePageWindows1252 uint16 = 1252
+	CodePageLatin1      uint16 = 28591
+	CodePageUTF8        uint16 = 65001
+)
+
+// CharacterSetChunk is a CSET chunk to declare the character set of the texts.
+type CharacterSetChunk struct {
+	CodePage    uint16
+	CountryCode uint16
+	Language    uint16
+	Dialect     uint16
+}
+
+func (c *CharacterSetChunk) Bytes() []byte {
+	b := make([]byte, csetSize)
+	binary.LittleEndian.PutUint16(b[0:2], c.CodePage)
+	binary.LittleEndian.PutUint16(b[2:4], c.CountryCode)
+	binary.LittleEndian.PutUint16(b[4:6], c.Language)
+	binary.LittleEndian.PutUint16(b[6:8], c.Dialect)
+	return b
+}
+
+func (c *CharacterSetChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      csetBytes,
+		Payload: c.Bytes(),
+	}
+}
+
+func (c *CharacterSetChunk) ReadFrom(r io.Reader) (int64, error) {
+	var b [csetSize]byte
+	n, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return int64(n), err
+	}
+
+	*c = CharacterSetChunk{
+		CodePage:    binary.LittleEndian.Uint16(b[0:2]),
+		CountryCode: binary.LittleEndian.Uint16(b[2:4]),
+		Language:    binary.LittleEndian.Uint16(b[4:6]),
+		Dialect:     binary.LittleEndian.Uint16(b[6:8]),
+	}
+	return int64(n), nil
+}
+
+// TextDecoder returns the decoder for the code page. It returns false if the code page is not supported.
+func (c *CharacterSetChunk) TextDecoder() (TextDecoder, bool) {
+	switch c.CodePage {
+	case CodePageUTF8:
+		return DecodeUTF8, true
+	case CodePageLatin1:
+		return DecodeLatin1, true
+	case CodePageWindows1252:
+		return DecodeWindows1252, true
+	default:
+		return nil, false
+	}
+}
+
+// TextEncoder returns the encoder for the code page. It returns false if the code page is not supported.
+func (c *CharacterSetChunk) TextEncoder() (TextEncoder, bool) {
+	return codePageTextEncoder(c.CodePage)
+}
+
+func codePageTextEncoder(codePage uint16) (TextEncoder, bool) {
+	switch codePage {
+	case CodePageUTF8:
+		return EncodeUTF8, true
+	case CodePageLatin1:
+		return EncodeLatin1, true
+	case CodePageWindows1252:
+		return EncodeWindows1252, true
+	default:
+		return nil, false
+	}
+}
+
+// TextDecoder decodes the text in a character set into UTF-8 string.
+type TextDecoder func(b []byte) string
+
+// DecodeUTF8 decodes the UTF-8 text. The invalid bytes are replaced with U+FFFD.
+func DecodeUTF8(b []byte) string {
+	return strings.ToValidUTF8(string(b), string(utf8.RuneError))
+}
+
+// DecodeLatin1 decodes the ISO-8859-1 text.
+func DecodeLatin1(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+// windows1252Runes is the characters of 0x80-0x9F in Windows-1252. The undefined bytes are mapped to the C1 control characters.
+var windows1252Runes = [32]rune{
+	0x20AC, 0x0081, 0x201A, 0x0192, 0x201E, 0x2026, 0x2020, 0x2021, 0x02C6, 0x2030, 0x0160, 0x2039, 0x0152, 0x008D, 0x017D, 0x008F,
+	0x0090, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014, 0x02DC, 0x2122, 0x0161, 0x203A, 0x0153, 0x009D, 0x017E, 0x0178,
+}
+
+// DecodeWindows1252 decodes the Windows-1252 text.
+func DecodeWindows1252(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		if 0x80 <= c && c <= 0x9F {
+			runes[i] = windows1252Runes[c-0x80]
+		} else {
+			runes[i] = rune(c)
+		}
+	}
+	return string(runes)
+}
+
+// TextEncoder encodes UTF-8 string into the text in a character set.
+type TextEncoder func(s string) []byte
+
+// EncodeUTF8 encodes the text as is.
+func EncodeUTF8(s string) []byte {
+	return []byte(s)
+}
+
+// EncodeLatin1 encodes the text into ISO-8859-1. The characters out of ISO-8859-1 are replaced with '?'.
+func EncodeLatin1(s string) []byte {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r > 0xFF {
+			r = '?'
+		}
+		b = append(b, byte(r))
+	}
+	return b
+}
+
+// EncodeWindows1252 encodes the text into Windows-1252. The characters out of Windows-1252 are replaced with '?'.
+func EncodeWindows1252(s string) []byte {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		b = append(b, windows1252Byte(r))
+	}
+	return b
+}
+
+func windows1252Byte(r rune) byte {
+	if r < 0x80 || (0xA0 <= r && r <= 0xFF) {
+		return byte(r)
+	}
+	for i, c := range windows1252Runes {
+		if c == r {
+			return byte(0x80 + i)
+		}
+	}
+	return '?'
+}
@@ -0,0 +1,83 @@
+package wavebin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// cuePointSize is the size of a cue point in the cue chunk.
+const cuePointSize = 24
+
+// CuePoint is a cue point to mark a position in the samples.
+type CuePoint struct {
+	ID           uint32
+	Position     uint32  // sample position in the play order
+	DataChunkID  [4]byte // "data" in usual
+	ChunkStart   uint32
+	BlockStart   uint32
+	SampleOffset uint32 // sample position in the data chunk
+}
+
+// CueChunk is a "cue " chunk.
+type CueChunk struct {
+	Points []CuePoint
+}
+
+func (c *CueChunk) Bytes() (b []byte) {
+	b = make([]byte, 4+cuePointSize*len(c.Points))
+
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(c.Points)))
+	for i, p := range c.Points {
+		pb := b[4+cuePointSize*i : 4+cuePointSize*(i+1)]
+		binary.LittleEndian.PutUint32(pb[0:4], p.ID)
+		binary.LittleEndian.PutUint32(pb[4:8], p.Position)
+		copy(pb[8:12], p.DataChunkID[:])
+		binary.LittleEndian.PutUint32(pb[12:16], p.ChunkStart)
+		binary.LittleEndian.PutUint32(pb[16:20], p.BlockStart)
+		binary.LittleEndian.PutUint32(pb[20:24], p.SampleOffset)
+	}
+
+	return
+}
+
+func (c *CueChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      cueBytes,
+		Payload: c.Bytes(),
+	}
+}
+
+func (c *CueChunk) ReadFrom(r io.Reader) (int64, error) {
+	var b [cuePointSize]byte
+
+	n, err := io.ReadFull(r, b[:4])
+	if err != nil {
+		return int64(n), err
+	}
+	read := int64(n)
+
+	count := binary.LittleEndian.Uint32(b[:4])
+	c.Points = make([]CuePoint, 0, count)
+	for i := uint32(0); i < count; i++ {
+		n, err := io.ReadFull(r, b[:])
+		read += int64(n)
+		if err != nil {
+			return read, fmt.Errorf("cue point[%d]: %w", i, err)
+		}
+
+		p := CuePoint{
+			ID:           binary.LittleEndian.Uint32(b[0:4]),
+			Position:     binary.LittleEndian.Uint32(b[4:8]),
+			ChunkStart:   binary.LittleEndian.Uint32(b[12:16]),
+			BlockStart:   binary.LittleEndian.Uint32(b[16:20]),
+			SampleOffset: binary.LittleEndian.Uint32(b[20:24]),
+		}
+		copy(p.DataChunkID[:], b[8:12])
+		c.Points = append(c.Points, p)
+	}
+
+	return read, nil
+}
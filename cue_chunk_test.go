@@ -0,0 +1,188 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestCueChunk(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		chunk *wavebin.CueChunk
+		size  uint32
+	}{
+		{
+			name:  "Empty",
+			chunk: &wavebin.CueChunk{Points: []wavebin.CuePoint{}},
+			size:  4,
+		},
+		{
+			name: "Points",
+			chunk: &wavebin.CueChunk{
+				Points: []wavebin.CuePoint{
+					{ID: 1, Position: 0, DataChunkID: [4]byte{'d', 'a', 't', 'a'}, SampleOffset: 0},
+					{ID: 2, Position: 44100, DataChunkID: [4]byte{'d', 'a', 't', 'a'}, SampleOffset: 44100},
+				},
+			},
+			size: 4 + 24*2,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := tt.chunk.Chunk().(*riffbin.OnMemorySubChunk)
+			if string(chunk.ChunkID()) != "cue " {
+				t.Errorf("unexpected chunk ID: %s", string(chunk.ChunkID()))
+			}
+			if chunk.BodySize() != tt.size {
+				t.Errorf("unexpected body size: %d", chunk.BodySize())
+			}
+
+			var got wavebin.CueChunk
+			_, err := got.ReadFrom(bytes.NewReader(chunk.Payload))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if df := cmp.Diff(tt.chunk, &got); df != "" {
+				t.Errorf("cue diff: %s", df)
+			}
+		})
+	}
+}
+
+func TestAssociatedDataListChunk(t *testing.T) {
+	cueChunk := &wavebin.CueChunk{
+		Points: []wavebin.CuePoint{
+			{ID: 1, Position: 0, DataChunkID: [4]byte{'d', 'a', 't', 'a'}},
+			{ID: 2, Position: 2, DataChunkID: [4]byte{'d', 'a', 't', 'a'}, SampleOffset: 2},
+		},
+	}
+	adtlChunk := &wavebin.AssociatedDataListChunk{
+		Labels: []wavebin.CueLabel{
+			{CuePointID: 1, Text: "Intro"},
+			{CuePointID: 2, Text: "Verse"},
+		},
+		Notes: []wavebin.CueNote{
+			{CuePointID: 2, Text: "retake"},
+		},
+		LabeledTexts: []wavebin.CueLabeledText{
+			{CuePointID: 1, SampleLength: 2, Purpose: [4]byte{'r', 'g', 'n', ' '}, Text: "region"},
+			{CuePointID: 2, SampleLength: 2, Purpose: [4]byte{'r', 'g', 'n', ' '}, CodePage: 1252},
+		},
+		Order: [][4]byte{
+			{'l', 'a', 'b', 'l'},
+			{'l', 't', 'x', 't'},
+			{'l', 'a', 'b', 'l'},
+			{'n', 'o', 't', 'e'},
+			{'l', 't', 'x', 't'},
+		},
+	}
+
+	listChunk := adtlChunk.Chunk().(*riffbin.ListChunk)
+	if listChunk.ListType != [4]byte{'a', 'd', 't', 'l'} {
+		t.Errorf("unexpected list type: %s", string(listChunk.ListType[:]))
+	}
+	var ids []string
+	for _, c := range listChunk.Payload {
+		ids = append(ids, string(c.ChunkID()))
+	}
+	if df := cmp.Diff([]string{"labl", "ltxt", "labl", "note", "ltxt"}, ids); df != "" {
+		t.Errorf("chunk IDs diff: %s", df)
+	}
+
+	t.Run("DefaultOrder", func(t *testing.T) {
+		chunk := *adtlChunk
+		chunk.Order = [][4]byte{{'n', 'o', 't', 'e'}}
+
+		var ids []string
+		for _, c := range chunk.Chunk().(*riffbin.ListChunk).Payload {
+			ids = append(ids, string(c.ChunkID()))
+		}
+		if df := cmp.Diff([]string{"note", "labl", "labl", "ltxt", "ltxt"}, ids); df != "" {
+			t.Errorf("chunk IDs diff: %s", df)
+		}
+	})
+
+	riffChunk := wavebin.CreateCompletedRIFF(
+		&wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		},
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		cueChunk,
+		adtlChunk,
+	)
+
+	chunks, err := wavebin.ParseWaveChunks(riffChunk, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if df := cmp.Diff(cueChunk, chunks.Cue); df != "" {
+		t.Errorf("cue diff: %s", df)
+	}
+	if df := cmp.Diff(adtlChunk, chunks.AssociatedData); df != "" {
+		t.Errorf("adtl diff: %s", df)
+	}
+
+	t.Run("PadByte", func(t *testing.T) {
+		// the odd-sized sub-chunks are followed by the pad bytes
+		var buf bytes.Buffer
+		w, err := wavebin.CreateStreamingSampleWriter(&buf, 0, &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		}, cueChunk, adtlChunk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		decoder, err := wavebin.NewDecoder(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if df := cmp.Diff(adtlChunk, decoder.Chunks().AssociatedData); df != "" {
+			t.Errorf("adtl diff: %s", df)
+		}
+	})
+
+	t.Run("UnknownSubChunk", func(t *testing.T) {
+		riffChunk := &riffbin.RIFFChunk{
+			FormType: [4]byte{'W', 'A', 'V', 'E'},
+			Payload: []riffbin.Chunk{
+				&riffbin.ListChunk{
+					ListType: [4]byte{'a', 'd', 't', 'l'},
+					Payload: []riffbin.Chunk{
+						&riffbin.OnMemorySubChunk{
+							ID:      [4]byte{'f', 'i', 'l', 'e'},
+							Payload: []byte{0x01, 0x00, 0x00, 0x00},
+						},
+					},
+				},
+			},
+		}
+
+		_, err := wavebin.ParseWaveChunks(riffChunk, false)
+		if !errors.Is(err, wavebin.ErrUnknownChunk) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+
+	t.Run("InvalidCueChunk", func(t *testing.T) {
+		_, err := wavebin.ParseWaveChunks(&riffbin.RIFFChunk{
+			FormType: [4]byte{'W', 'A', 'V', 'E'},
+			Payload: []riffbin.Chunk{
+				&riffbin.OnMemorySubChunk{
+					ID:      [4]byte{'c', 'u', 'e', ' '},
+					Payload: []byte{0x02, 0x00, 0x00, 0x00},
+				},
+			},
+		}, false)
+		if !errors.Is(err, wavebin.ErrUnexpectedChunkSize) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+}
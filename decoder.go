@@ -0,0 +1,64 @@
+package wavebin
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// Decoder decodes a WAVE binary stream.
+type Decoder struct {
+	chunks *WaveChunks
+}
+
+// NewDecoder reads a RIFF, RF64 or BW64 WAVE binary from the reader and parses its chunks. Unknown chunks are ignored.
+// If r implements riffbin.PartialReader (e.g. *os.File), the samples are not loaded on memory and read from r lazily.
+func NewDecoder(r io.Reader) (*Decoder, error) {
+	riffChunk, err := ReadWaveRIFF(r)
+	if err != nil {
+		return nil, fmt.Errorf("read RIFF: %w", err)
+	}
+
+	chunks, err := ParseWaveChunks(riffChunk, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Decoder{chunks: chunks}, nil
+}
+
+// Chunks returns all of the known chunks.
+func (d *Decoder) Chunks() *WaveChunks {
+	return d.chunks
+}
+
+// Format returns the format of the samples.
+func (d *Decoder) Format() FormatChunk {
+	return d.chunks.Format
+}
+
+// Info returns the INFO list chunk. It returns nil if the stream has no INFO list chunk.
+func (d *Decoder) Info() *InfoChunk {
+	return d.chunks.Info
+}
+
+// Fact returns the fact chunk. It returns nil if the stream has no fact chunk.
+func (d *Decoder) Fact() *FactChunk {
+	return d.chunks.Fact
+}
+
+// Data returns the raw data chunk to read the samples with PCMReader.
+func (d *Decoder) Data() riffbin.SubChunk {
+	return d.chunks.Data
+}
+
+// NormalizedPCMReader returns a reader for the samples chosen by the format.
+func (d *Decoder) NormalizedPCMReader() (*NormalizedPCMReader, error) {
+	return NewNormalizedPCMReader(d.chunks.Data, d.chunks.Format)
+}
+
+// FrameReader returns a reader for the sample frames at random positions.
+func (d *Decoder) FrameReader() (*FrameReader, error) {
+	return NewDataFrameReader(d.chunks.Data, d.chunks.Format)
+}
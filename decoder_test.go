@@ -0,0 +1,84 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestDecoder(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := riffbin.NewCompletedChunkWriter(&buf).Write(
+		wavebin.CreateCompletedRIFF(
+			&wavebin.ExtendedFormatChunk{
+				MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+			},
+			[]byte{0x00, 0x80, 0xff, 0x7f, 0x00, 0x40, 0x00, 0x00},
+			&wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{
+				wavebin.InfoArtistIART: "AAA",
+			}},
+			&wavebin.FactChunk{SampleLength: 2},
+		),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		r    io.Reader
+	}{
+		{"PartialReader", bytes.NewReader(buf.Bytes())},
+		{"Reader", io.MultiReader(bytes.NewReader(buf.Bytes()))},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := wavebin.NewDecoder(tt.r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if d.Format().Channels() != 2 || d.Format().SamplesPerSecond() != 44100 || d.Format().SignificantBitsPerSample() != 16 {
+				t.Errorf("unexpected format: %+v", d.Format())
+			}
+			if df := cmp.Diff(&wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoArtistIART: "AAA"}}, d.Info()); df != "" {
+				t.Errorf("info diff: %s", df)
+			}
+			if df := cmp.Diff(&wavebin.FactChunk{SampleLength: 2}, d.Fact()); df != "" {
+				t.Errorf("fact diff: %s", df)
+			}
+
+			r, err := d.NormalizedPCMReader()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var frames [][]float64
+			for {
+				frame, err := r.ReadSample()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				frames = append(frames, frame)
+			}
+			if df := cmp.Diff([][]float64{{-1, 32767.0 / 32768.0}, {0.5, 0}}, frames); df != "" {
+				t.Errorf("frames diff: %s", df)
+			}
+		})
+	}
+
+	t.Run("InvalidRIFF", func(t *testing.T) {
+		_, err := wavebin.NewDecoder(bytes.NewReader([]byte("RIFX")))
+		if err == nil {
+			t.Error("should not be nil err")
+		}
+	})
+}
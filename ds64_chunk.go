@@ -0,0 +1,87 @@
+package wavebin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// DataSize64Chunk is a ds64 chunk of RF64/BW64 to have 64bit sizes of the chunks.
+type DataSize64Chunk struct {
+	RIFFSize    uint64
+	DataSize    uint64
+	SampleCount uint64
+	Table       []DataSize64TableEntry
+}
+
+// DataSize64TableEntry is a 64bit size of the chunk other than RIFF and data.
+type DataSize64TableEntry struct {
+	ChunkID   [4]byte
+	ChunkSize uint64
+}
+
+func (c *DataSize64Chunk) Chunk() riffbin.Chunk {
+	b := make([]byte, ds64BodySize+12*len(c.Table))
+	binary.LittleEndian.PutUint64(b[0:8], c.RIFFSize)
+	binary.LittleEndian.PutUint64(b[8:16], c.DataSize)
+	binary.LittleEndian.PutUint64(b[16:24], c.SampleCount)
+	binary.LittleEndian.PutUint32(b[24:28], uint32(len(c.Table)))
+	for i, entry := range c.Table {
+		off := ds64BodySize + 12*i
+		copy(b[off:off+4], entry.ChunkID[:])
+		binary.LittleEndian.PutUint64(b[off+4:off+12], entry.ChunkSize)
+	}
+
+	return &riffbin.OnMemorySubChunk{
+		ID:      ds64Bytes,
+		Payload: b,
+	}
+}
+
+func (c *DataSize64Chunk) ReadFrom(r io.Reader) (int64, error) {
+	var b [ds64BodySize]byte
+	n, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return int64(n), err
+	}
+
+	c.RIFFSize = binary.LittleEndian.Uint64(b[0:8])
+	c.DataSize = binary.LittleEndian.Uint64(b[8:16])
+	c.SampleCount = binary.LittleEndian.Uint64(b[16:24])
+	tableLength := binary.LittleEndian.Uint32(b[24:28])
+	if tableLength > maxDS64TableLength {
+		return int64(n), fmt.Errorf("%w: %d table entries", ErrUnexpectedChunkSize, tableLength)
+	}
+
+	c.Table = make([]DataSize64TableEntry, 0, tableLength)
+	read := int64(n)
+	for i := uint32(0); i < tableLength; i++ {
+		var e [12]byte
+		n, err := io.ReadFull(r, e[:])
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+
+		entry := DataSize64TableEntry{ChunkSize: binary.LittleEndian.Uint64(e[4:12])}
+		copy(entry.ChunkID[:], e[0:4])
+		c.Table = append(c.Table, entry)
+	}
+
+	return read, nil
+}
+
+// chunkSize returns the 64bit size of the chunk. It returns false if the ds64 chunk does not have it.
+func (c *DataSize64Chunk) chunkSize(id [4]byte) (uint64, bool) {
+	if id == dataBytes {
+		return c.DataSize, true
+	}
+	for _, entry := range c.Table {
+		if entry.ChunkID == id {
+			return entry.ChunkSize, true
+		}
+	}
+	return 0, false
+}
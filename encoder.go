@@ -0,0 +1,152 @@
+package wavebin
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// Encoder encodes typed samples to a WAVE binary.
+// The RIFF header and the fact chunk are finalized on Close.
+type Encoder[T PCMSample] struct {
+	w          io.WriteSeeker
+	sw         io.WriteCloser
+	bw         *bufio.Writer
+	pw         PCMWriter[T]
+	blockAlign int
+	fact       *FactChunk
+	factOffset int64
+}
+
+// NewEncoder writes the WAVE header to w and creates an Encoder for the samples.
+// If the format requires a fact chunk and extras has no *FactChunk, it is added automatically.
+// The fact chunk is written with the number of the written samples on Close, and the given *FactChunk is not modified.
+func NewEncoder[T PCMSample](w io.WriteSeeker, format FormatChunk, extras ...ChunkProvider) (*Encoder[T], error) {
+	head, err := w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, fmt.Errorf("seek: %w", err)
+	}
+
+	// copy extras not to modify the caller's slice
+	extras = append([]ChunkProvider(nil), extras...)
+
+	factIndex := -1
+	for i, extra := range extras {
+		if _, ok := extra.(*FactChunk); ok {
+			factIndex = i
+			break
+		}
+	}
+	if factIndex == -1 && needsFactChunk(format) {
+		extras = append([]ChunkProvider{&FactChunk{}}, extras...)
+		factIndex = 0
+	}
+
+	var fact *FactChunk
+	var factOffset int64
+	if factIndex != -1 {
+		// copy the fact chunk not to modify the caller's one
+		factCopy := *extras[factIndex].(*FactChunk)
+		fact = &factCopy
+		fact.SampleLength = 0
+		extras[factIndex] = fact
+
+		// RIFF header + form type + fmt chunk + chunks before fact chunk + fact chunk header, the chunks are padded to even size
+		factOffset = head + riffbin.HeaderBytes + 4 + riffbin.HeaderBytes + int64(paddedSize(chunkBodySize(format.Chunk())))
+		for i, extra := range extras[:factIndex] {
+			chunk := extra.Chunk()
+			factOffset += riffbin.HeaderBytes + int64(paddedSize(chunkBodySize(chunk)))
+			extras[i] = providedChunk{chunk: chunk}
+		}
+		factOffset += riffbin.HeaderBytes
+	}
+
+	sw, err := CreateSampleWriter(w, format, extras...)
+	if err != nil {
+		return nil, err
+	}
+
+	bw := bufio.NewWriter(sw)
+	return &Encoder[T]{
+		w:          w,
+		sw:         sw,
+		bw:         bw,
+		pw:         PCMWriter[T]{W: bw},
+		blockAlign: int(format.BlockAlign()),
+		fact:       fact,
+		factOffset: factOffset,
+	}, nil
+}
+
+// WriteSamples writes the samples. It returns ErrUnexpectedBlockAlign if the size of a sample does not match the block align of the format.
+func (e *Encoder[T]) WriteSamples(samples ...T) (int64, error) {
+	for _, sample := range samples {
+		if sample.ByteSize() != e.blockAlign {
+			return 0, fmt.Errorf("%w: sample is %d bytes but block align is %d", ErrUnexpectedBlockAlign, sample.ByteSize(), e.blockAlign)
+		}
+	}
+
+	n, err := e.pw.WriteSamples(samples...)
+	if e.fact != nil {
+		e.fact.SampleLength += SampleLength(n / int64(e.blockAlign))
+	}
+	return n, err
+}
+
+// Close flushes the buffered samples and finalizes the WAVE header.
+func (e *Encoder[T]) Close() error {
+	if err := e.bw.Flush(); err != nil {
+		_ = e.sw.Close()
+		return err
+	}
+	if err := e.sw.Close(); err != nil {
+		return err
+	}
+
+	if e.fact != nil {
+		return e.finalizeFactChunk()
+	}
+	return nil
+}
+
+func (e *Encoder[T]) finalizeFactChunk() error {
+	tail, err := e.w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+
+	_, err = e.w.Seek(e.factOffset, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(e.fact.SampleLength))
+	_, err = e.w.Write(b[:])
+	if err != nil {
+		return fmt.Errorf("RIFF[WAVE].fact: %w", err)
+	}
+
+	_, err = e.w.Seek(tail, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+
+	return nil
+}
+
+func needsFactChunk(format MetaFormat) bool {
+	return CompressionCode(EffectiveCompressionCode(format)) != pcmCompressionCode
+}
+
+// providedChunk is a ChunkProvider of the already created chunk.
+type providedChunk struct {
+	chunk riffbin.Chunk
+}
+
+func (p providedChunk) Chunk() riffbin.Chunk {
+	return p.chunk
+}
@@ -0,0 +1,228 @@
+package wavebin_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestEncoder(t *testing.T) {
+	t.Run("PCM", func(t *testing.T) {
+		f, err := os.CreateTemp("", "wavebin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		e, err := wavebin.NewEncoder[wavebin.PCM16BitStereoSample](f, &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+		}, &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{
+			wavebin.InfoArtistIART: "AAA",
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = e.WriteSamples(wavebin.PCM16BitStereoSample{L: -32768, R: 16384}, wavebin.PCM16BitStereoSample{L: 0, R: 0})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = e.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = f.Seek(0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := wavebin.NewDecoder(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Fact() != nil {
+			t.Errorf("PCM should not have fact chunk: %+v", d.Fact())
+		}
+		if df := cmp.Diff(&wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoArtistIART: "AAA"}}, d.Info()); df != "" {
+			t.Errorf("info diff: %s", df)
+		}
+		assertNormalizedFrames(t, d, [][]float64{{-1, 0.5}, {0, 0}})
+	})
+
+	t.Run("IEEEFloat", func(t *testing.T) {
+		f, err := os.CreateTemp("", "wavebin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		e, err := wavebin.NewEncoder[wavebin.IEEEFloat32BitMonoralSample](f, &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewIEEEFloatMetaFormat(wavebin.MonoralChannels, 48000, 32),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, sample := range []wavebin.IEEEFloat32BitMonoralSample{1, -0.5, 0.25} {
+			_, err = e.WriteSamples(sample)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		err = e.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = f.Seek(0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := wavebin.NewDecoder(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if df := cmp.Diff(&wavebin.FactChunk{SampleLength: 3}, d.Fact()); df != "" {
+			t.Errorf("fact diff: %s", df)
+		}
+		assertNormalizedFrames(t, d, [][]float64{{1}, {-0.5}, {0.25}})
+	})
+
+	t.Run("GivenFactChunk", func(t *testing.T) {
+		f, err := os.CreateTemp("", "wavebin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		info := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoTitleINAM: "Title"}}
+		fact := &wavebin.FactChunk{SampleLength: 99}
+		extras := []wavebin.ChunkProvider{info, fact}
+		e, err := wavebin.NewEncoder[wavebin.IEEEFloat32BitMonoralSample](f, &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewIEEEFloatMetaFormat(wavebin.MonoralChannels, 48000, 32),
+		}, extras...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = e.WriteSamples(1, -0.5, 0.25)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = e.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if fact.SampleLength != 99 {
+			t.Errorf("given fact chunk should not be modified: %+v", fact)
+		}
+		if extras[0] != info || extras[1] != fact {
+			t.Errorf("given extras should not be modified: %+v", extras)
+		}
+
+		_, err = f.Seek(0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := wavebin.NewDecoder(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if df := cmp.Diff(&wavebin.FactChunk{SampleLength: 3}, d.Fact()); df != "" {
+			t.Errorf("fact diff: %s", df)
+		}
+		if df := cmp.Diff(info, d.Info()); df != "" {
+			t.Errorf("info diff: %s", df)
+		}
+	})
+
+	t.Run("OddChunkBeforeFactChunk", func(t *testing.T) {
+		f, err := os.CreateTemp("", "wavebin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		odd := &wavebin.RawChunk{ID: [4]byte{'o', 'd', 'd', ' '}, Payload: []byte{0x01, 0x02, 0x03}}
+		e, err := wavebin.NewEncoder[wavebin.IEEEFloat32BitMonoralSample](f, &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewIEEEFloatMetaFormat(wavebin.MonoralChannels, 48000, 32),
+		}, odd, &wavebin.FactChunk{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = e.WriteSamples(1, -0.5, 0.25)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = e.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = f.Seek(0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := wavebin.NewDecoder(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if df := cmp.Diff(&wavebin.FactChunk{SampleLength: 3}, d.Fact()); df != "" {
+			t.Errorf("fact diff: %s", df)
+		}
+		if df := cmp.Diff([]wavebin.ChunkProvider{odd}, d.Chunks().Unknown, readSectionChunk); df != "" {
+			t.Errorf("unknown chunks diff: %s", df)
+		}
+	})
+
+	t.Run("UnexpectedBlockAlign", func(t *testing.T) {
+		f, err := os.CreateTemp("", "wavebin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		e, err := wavebin.NewEncoder[wavebin.PCM16BitMonoralSample](f, &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer e.Close()
+
+		_, err = e.WriteSamples(0)
+		if !errors.Is(err, wavebin.ErrUnexpectedBlockAlign) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+}
+
+func assertNormalizedFrames(t *testing.T, d *wavebin.Decoder, expected [][]float64) {
+	t.Helper()
+
+	r, err := d.NormalizedPCMReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frames := make([][]float64, 0, len(expected))
+	for i := 0; i < len(expected); i++ {
+		frame, err := r.ReadSample()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		frames = append(frames, frame)
+	}
+	if df := cmp.Diff(expected, frames); df != "" {
+		t.Errorf("frames diff: %s", df)
+	}
+}
@@ -0,0 +1,8 @@
+package wavebin
+
+// SetMaxRIFFSize overrides the max size of the RIFF chunk body to test large files without writing 4GiB.
+func SetMaxRIFFSize(size uint64) (restore func()) {
+	orig := maxRIFFSize
+	maxRIFFSize = size
+	return func() { maxRIFFSize = orig }
+}
@@ -116,9 +116,39 @@ func (f *ExtendedFormatChunk) ReadFrom(r io.Reader) (int64, error) {
 		return int64(len(b) + 2 + n), err
 	}
 
+	if CompressionCode(mf.compressionCode) == extensibleCompressionCode {
+		if ef, ok := parseExtensibleMetaFormat(mf); ok {
+			f.MetaFormat = ef
+		}
+	}
+
 	return int64(len(b) + 2 + n), nil
 }
 
+// parseExtensibleMetaFormat converts the raw format to ExtensibleMetaFormat.
+// It returns false if the raw format cannot be represented as ExtensibleMetaFormat without loss.
+func parseExtensibleMetaFormat(mf *rawMetaFormat) (*ExtensibleMetaFormat, bool) {
+	if len(mf.extraField) != 22 {
+		return nil, false
+	}
+
+	var subFormat SubFormat
+	copy(subFormat[:], mf.extraField[6:22])
+	ef := NewExtensibleMetaFormat(
+		Channels(mf.channels),
+		SamplesPerSecond(mf.samplesPerSecond),
+		SignificantBitsPerSample(mf.significantBitsPerSample),
+		ValidBitsPerSample(binary.LittleEndian.Uint16(mf.extraField[0:2])),
+		ChannelMask(binary.LittleEndian.Uint32(mf.extraField[2:6])),
+		subFormat,
+	)
+	if ef.BlockAlign() != mf.blockAlign || ef.AverageBytesPerSecond() != mf.averageBytesPerSecond {
+		return nil, false
+	}
+
+	return ef, true
+}
+
 func (f *ExtendedFormatChunk) Chunk() riffbin.Chunk {
 	return &riffbin.OnMemorySubChunk{
 		ID:      fmtBytes,
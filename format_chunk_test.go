@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/karupanerura/wavebin"
 )
 
@@ -75,3 +76,79 @@ func TestExtendedFormatChunk(t *testing.T) {
 		}
 	})
 }
+
+func TestExtendedFormatChunk_ReadFrom(t *testing.T) {
+	t.Run("Extensible", func(t *testing.T) {
+		b := []byte{
+			0xFE, 0xFF, // Compression Code (Extensible)
+			0x06, 0x00, // Number of channels (5.1ch)
+			0x80, 0xBB, 0x00, 0x00, // Sample rate (48kHz)
+			0x00, 0x2F, 0x0D, 0x00, // Average bytes per second (48kHz/5.1ch/24bit)
+			0x12, 0x00, // Block align (24bit/5.1ch)
+			0x18, 0x00, // Significant bits per sample (24bit)
+			0x16, 0x00, // extra field size
+			0x14, 0x00, // ValidBitsPerSample (20bit)
+			0x3F, 0x00, 0x00, 0x00, // ChannelMask (5.1ch)
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, // SubFormatChunk (PCM)
+			0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71,
+		}
+
+		var f wavebin.ExtendedFormatChunk
+		n, err := f.ReadFrom(bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != int64(len(b)) {
+			t.Errorf("n should be %d but got: %d", len(b), n)
+		}
+
+		ef, ok := f.MetaFormat.(*wavebin.ExtensibleMetaFormat)
+		if !ok {
+			t.Fatalf("MetaFormat should be *wavebin.ExtensibleMetaFormat but got: %T", f.MetaFormat)
+		}
+		if ef.ValidBitsPerSample() != 20 {
+			t.Errorf("ValidBitsPerSample should be 20 but got: %d", ef.ValidBitsPerSample())
+		}
+		if expected := wavebin.ChannelMaskFrontLeft | wavebin.ChannelMaskFrontRight | wavebin.ChannelMaskFrontCenter | wavebin.ChannelMaskLowFrequency | wavebin.ChannelMaskBackLeft | wavebin.ChannelMaskBackRight; ef.ChannelMask() != expected {
+			t.Errorf("ChannelMask should be %08X but got: %08X", expected, ef.ChannelMask())
+		}
+		if df := cmp.Diff(wavebin.SubFormatPCM, ef.SubFormat()); df != "" {
+			t.Errorf("SubFormat diff: %s", df)
+		}
+		if !bytes.Equal(f.Bytes(), b) {
+			t.Errorf("Invalid Bytes: %v", f.Bytes())
+			t.Log(hex.Dump(f.Bytes()))
+			t.Log(hex.Dump(b))
+		}
+	})
+	t.Run("InconsistentExtensible", func(t *testing.T) {
+		b := []byte{
+			0xFE, 0xFF, // Compression Code (Extensible)
+			0x01, 0x00, // Number of channels (Monoral)
+			0x44, 0xAC, 0x00, 0x00, // Sample rate (44.1Hz)
+			0x00, 0x00, 0x00, 0x00, // Average bytes per second (broken)
+			0x01, 0x00, // Block align (8bit/Monoral)
+			0x08, 0x00, // Significant bits per sample (8bit)
+			0x16, 0x00, // extra field size
+			0x08, 0x00, // ValidBitsPerSample
+			0x04, 0x00, 0x00, 0x00, // ChannelMask (FrontCenter)
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, // SubFormatChunk (PCM)
+			0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71,
+		}
+
+		var f wavebin.ExtendedFormatChunk
+		_, err := f.ReadFrom(bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := f.MetaFormat.(*wavebin.ExtensibleMetaFormat); ok {
+			t.Error("MetaFormat should not be *wavebin.ExtensibleMetaFormat")
+		}
+		if !bytes.Equal(f.Bytes(), b) {
+			t.Errorf("Invalid Bytes: %v", f.Bytes())
+			t.Log(hex.Dump(f.Bytes()))
+			t.Log(hex.Dump(b))
+		}
+	})
+}
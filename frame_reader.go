@@ -0,0 +1,124 @@
+package wavebin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// FrameReader reads the sample frames of the data chunk at random positions.
+// A frame is BlockAlign bytes that have a sample for each channel.
+type FrameReader struct {
+	r          *io.SectionReader
+	blockAlign int64
+	pos        int64 // byte position for Read
+}
+
+// NewFrameReader creates a FrameReader for the data chunk body of size bytes at offset in r.
+func NewFrameReader(r io.ReaderAt, offset, size int64, format MetaFormat) (*FrameReader, error) {
+	if format.BlockAlign() == 0 {
+		return nil, fmt.Errorf("%w: zero block align", ErrUnexpectedBlockAlign)
+	}
+
+	return &FrameReader{
+		r:          io.NewSectionReader(r, offset, size),
+		blockAlign: int64(format.BlockAlign()),
+	}, nil
+}
+
+// NewFrameReaderFromSeeker creates a FrameReader as well as NewFrameReader, but it reads r by seeking.
+// r must not be used by the others while reading frames.
+func NewFrameReaderFromSeeker(r io.ReadSeeker, offset, size int64, format MetaFormat) (*FrameReader, error) {
+	if ra, ok := r.(io.ReaderAt); ok {
+		return NewFrameReader(ra, offset, size, format)
+	}
+	return NewFrameReader(&seekReaderAt{r: r}, offset, size, format)
+}
+
+// NewDataFrameReader creates a FrameReader for the data chunk returned by the parser.
+// The data chunk must be *riffbin.InStreamSubChunk or *riffbin.OnMemorySubChunk.
+func NewDataFrameReader(data riffbin.SubChunk, format MetaFormat) (*FrameReader, error) {
+	switch c := data.(type) {
+	case *riffbin.InStreamSubChunk:
+		return NewFrameReader(c.SectionReader, 0, c.Size(), format)
+	case *riffbin.OnMemorySubChunk:
+		return NewFrameReader(bytes.NewReader(c.Payload), 0, int64(len(c.Payload)), format)
+	default:
+		return nil, fmt.Errorf("RIFF[WAVE].data: %w: not random-accessible", ErrUnexpectedChunkType)
+	}
+}
+
+// FrameCount returns the number of the frames in the data chunk.
+func (r *FrameReader) FrameCount() int64 {
+	return r.r.Size() / r.blockAlign
+}
+
+// SeekFrame sets the frame position for the next Read as well as io.Seeker, and returns the new frame position.
+func (r *FrameReader) SeekFrame(frame int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		frame += r.pos / r.blockAlign
+	case io.SeekEnd:
+		frame += r.FrameCount()
+	default:
+		return 0, fmt.Errorf("%w: invalid whence %d", ErrInvalidFrameOffset, whence)
+	}
+	if frame < 0 {
+		return 0, fmt.Errorf("%w: negative frame %d", ErrInvalidFrameOffset, frame)
+	}
+
+	r.pos = frame * r.blockAlign
+	return frame, nil
+}
+
+// ReadFramesAt reads the frames from frameOffset into buf, and returns the number of the frames read.
+// The length of buf must be a multiple of BlockAlign. It returns io.EOF if the frames are fewer than buf.
+// It does not change the position for Read.
+func (r *FrameReader) ReadFramesAt(buf []byte, frameOffset int64) (int, error) {
+	if int64(len(buf))%r.blockAlign != 0 {
+		return 0, fmt.Errorf("%w: %d bytes buffer for %d bytes frames", ErrUnexpectedBlockAlign, len(buf), r.blockAlign)
+	}
+	if frameOffset < 0 {
+		return 0, fmt.Errorf("%w: negative frame %d", ErrInvalidFrameOffset, frameOffset)
+	}
+
+	n, err := r.r.ReadAt(buf, frameOffset*r.blockAlign)
+	return n / int(r.blockAlign), err
+}
+
+// Read reads the samples from the current position as io.Reader.
+// It can be passed to PCMReader or NormalizedPCMReader to read the samples from the position set by SeekFrame.
+func (r *FrameReader) Read(p []byte) (int, error) {
+	n, err := r.r.ReadAt(p, r.pos)
+	r.pos += int64(n)
+	if err == io.EOF && n != 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// seekReaderAt is io.ReaderAt by seeking io.ReadSeeker.
+type seekReaderAt struct {
+	mu sync.Mutex
+	r  io.ReadSeeker
+}
+
+func (s *seekReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.r.Seek(off, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("seek: %w", err)
+	}
+
+	n, err := io.ReadFull(s.r, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
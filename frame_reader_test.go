@@ -0,0 +1,162 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+// readSeekerOnly hides io.ReaderAt of the underlying reader.
+type readSeekerOnly struct {
+	io.ReadSeeker
+}
+
+func TestFrameReader(t *testing.T) {
+	samples := []byte{
+		0x00, 0x00, 0x01, 0x00, // frame 0
+		0x02, 0x00, 0x03, 0x00, // frame 1
+		0x04, 0x00, 0x05, 0x00, // frame 2
+		0x06, 0x00, 0x07, 0x00, // frame 3
+		0x08, 0x00, 0x09, 0x00, // frame 4
+	}
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+	}
+
+	var buf bytes.Buffer
+	_, err := riffbin.NewCompletedChunkWriter(&buf).Write(wavebin.CreateCompletedRIFF(format, samples))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataOffset := int64(bytes.Index(buf.Bytes(), []byte("data")) + 8)
+
+	for _, tt := range []struct {
+		name string
+		open func() (*wavebin.FrameReader, error)
+	}{
+		{
+			name: "Decoder",
+			open: func() (*wavebin.FrameReader, error) {
+				decoder, err := wavebin.NewDecoder(bytes.NewReader(buf.Bytes()))
+				if err != nil {
+					return nil, err
+				}
+				return decoder.FrameReader()
+			},
+		},
+		{
+			name: "OnMemoryDecoder",
+			open: func() (*wavebin.FrameReader, error) {
+				decoder, err := wavebin.NewDecoder(io.MultiReader(bytes.NewReader(buf.Bytes())))
+				if err != nil {
+					return nil, err
+				}
+				return decoder.FrameReader()
+			},
+		},
+		{
+			name: "ReaderAt",
+			open: func() (*wavebin.FrameReader, error) {
+				return wavebin.NewFrameReader(bytes.NewReader(buf.Bytes()), dataOffset, int64(len(samples)), format)
+			},
+		},
+		{
+			name: "ReadSeeker",
+			open: func() (*wavebin.FrameReader, error) {
+				return wavebin.NewFrameReaderFromSeeker(readSeekerOnly{bytes.NewReader(buf.Bytes())}, dataOffset, int64(len(samples)), format)
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := tt.open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.FrameCount() != 5 {
+				t.Errorf("unexpected frame count: %d", r.FrameCount())
+			}
+
+			frames := make([]byte, 8)
+			n, err := r.ReadFramesAt(frames, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != 2 {
+				t.Errorf("unexpected frames: %d", n)
+			}
+			if df := cmp.Diff(samples[4:12], frames); df != "" {
+				t.Errorf("frames diff: %s", df)
+			}
+
+			n, err = r.ReadFramesAt(frames, 4)
+			if !errors.Is(err, io.EOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+			if n != 1 {
+				t.Errorf("unexpected frames: %d", n)
+			}
+			if df := cmp.Diff(samples[16:20], frames[:4]); df != "" {
+				t.Errorf("frames diff: %s", df)
+			}
+
+			_, err = r.ReadFramesAt(frames[:3], 0)
+			if !errors.Is(err, wavebin.ErrUnexpectedBlockAlign) {
+				t.Errorf("unexpected err: %v", err)
+			}
+			_, err = r.ReadFramesAt(frames, -1)
+			if !errors.Is(err, wavebin.ErrInvalidFrameOffset) {
+				t.Errorf("unexpected err: %v", err)
+			}
+
+			frame, err := r.SeekFrame(3, io.SeekStart)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if frame != 3 {
+				t.Errorf("unexpected frame: %d", frame)
+			}
+
+			reader := wavebin.NewPCMReader[wavebin.PCM16BitStereoSample](r, wavebin.PCM16BitStereoSampleParser{})
+			var got []wavebin.PCM16BitStereoSample
+			for {
+				sample, err := reader.ReadSample()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, sample)
+			}
+			if df := cmp.Diff([]wavebin.PCM16BitStereoSample{{L: 6, R: 7}, {L: 8, R: 9}}, got); df != "" {
+				t.Errorf("samples diff: %s", df)
+			}
+
+			frame, err = r.SeekFrame(-2, io.SeekCurrent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if frame != 3 {
+				t.Errorf("unexpected frame: %d", frame)
+			}
+
+			frame, err = r.SeekFrame(-1, io.SeekEnd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if frame != 4 {
+				t.Errorf("unexpected frame: %d", frame)
+			}
+
+			_, err = r.SeekFrame(-6, io.SeekEnd)
+			if !errors.Is(err, wavebin.ErrInvalidFrameOffset) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ module github.com/karupanerura/wavebin
 
 go 1.18
 
-require github.com/karupanerura/riffbin v0.0.6
-
 require (
-	github.com/google/go-cmp v0.5.7 // indirect
-	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c // indirect
+	github.com/google/go-cmp v0.5.7
+	github.com/karupanerura/riffbin v0.0.6
 )
+
+require golang.org/x/sync v0.0.0-20210220032951-036812b2e83c // indirect
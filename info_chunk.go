@@ -1,6 +1,13 @@
 package wavebin
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/karupanerura/riffbin"
 )
 
@@ -98,14 +105,28 @@ var (
 
 type InfoChunk struct {
 	Data map[InfoKey]string
+
+	// Order is the order of the keys to write. The other keys are written after them in the sorted order.
+	Order []InfoKey
+
+	// CodePage is the code page to encode the texts on writing, and it's set on parsing from the CSET chunk.
+	// The texts are written as is if it's zero or not supported by CharacterSetChunk.TextEncoder.
+	CodePage uint16
 }
 
+// Chunk returns the INFO list chunk. The texts are NUL-terminated.
 func (f *InfoChunk) Chunk() riffbin.Chunk {
-	payload := make([]riffbin.Chunk, 0, len(f.Data))
-	for key, value := range f.Data {
+	encode, ok := codePageTextEncoder(f.CodePage)
+	if !ok {
+		encode = EncodeUTF8
+	}
+
+	keys := f.keys()
+	payload := make([]riffbin.Chunk, 0, len(keys))
+	for _, key := range keys {
 		payload = append(payload, &riffbin.OnMemorySubChunk{
 			ID:      key,
-			Payload: []byte(value),
+			Payload: infoTextBytes(encode(f.Data[key])),
 		})
 	}
 
@@ -114,3 +135,203 @@ func (f *InfoChunk) Chunk() riffbin.Chunk {
 		Payload:  payload,
 	}
 }
+
+// keys returns the keys of Data in Order and the sorted order.
+func (f *InfoChunk) keys() []InfoKey {
+	keys := make([]InfoKey, 0, len(f.Data))
+	seen := make(map[InfoKey]bool, len(f.Data))
+	for _, key := range f.Order {
+		if _, ok := f.Data[key]; ok && !seen[key] {
+			keys = append(keys, key)
+			seen[key] = true
+		}
+	}
+
+	rest := len(keys)
+	for key := range f.Data {
+		if !seen[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys[rest:], func(i, j int) bool {
+		return bytes.Compare(keys[rest+i][:], keys[rest+j][:]) < 0
+	})
+
+	return keys
+}
+
+func infoTextBytes(s []byte) []byte {
+	b := make([]byte, len(s)+1)
+	copy(b, s)
+	return b
+}
+
+// The keys for the same field. The first one is canonical and the others are read as the aliases.
+var (
+	infoTitleKeys       = []InfoKey{InfoTitleINAM, InfoTitleTITL}
+	infoArtistKeys      = []InfoKey{InfoArtistIART}
+	infoCommentKeys     = []InfoKey{InfoCommentICMT, InfoCommentCMNT, InfoCommentsCOMM}
+	infoGenreKeys       = []InfoKey{InfoGenreIGNR, InfoGenreGENR}
+	infoSoftwareKeys    = []InfoKey{InfoSoftwareISFT}
+	infoDateCreatedKeys = []InfoKey{InfoDateCreatedICRD}
+	infoTrackNumberKeys = []InfoKey{InfoTrackNumberITRK, InfoTrackNumberTRCK}
+	infoLanguageKeys    = []InfoKey{
+		InfoFirstLanguageIAS1, InfoSecondLanguageIAS2, InfoThirdLanguageIAS3,
+		InfoFourthLanguageIAS4, InfoFifthLanguageIAS5, InfoSixthLanguageIAS6,
+		InfoSeventhLanguageIAS7, InfoEighthLanguageIAS8, InfoNinthLanguageIAS9,
+	}
+)
+
+// infoDateLayouts is the layouts of ICRD. The first one is written.
+var infoDateLayouts = []string{"2006-01-02", "2006-01-02T15:04:05Z07:00", "2006-01-02 15:04:05", "2006-01", "2006"}
+
+// get returns the first non-empty value of the keys.
+func (f *InfoChunk) get(keys []InfoKey) string {
+	for _, key := range keys {
+		if value := f.Data[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// set sets the value to the canonical key and deletes the aliases. The empty value deletes all of them.
+func (f *InfoChunk) set(keys []InfoKey, value string) {
+	for _, key := range keys {
+		delete(f.Data, key)
+	}
+	if value == "" {
+		return
+	}
+
+	if f.Data == nil {
+		f.Data = map[InfoKey]string{}
+	}
+	f.Data[keys[0]] = value
+}
+
+// Title returns the title in INAM or TITL.
+func (f *InfoChunk) Title() string {
+	return f.get(infoTitleKeys)
+}
+
+// SetTitle sets the title to INAM.
+func (f *InfoChunk) SetTitle(title string) {
+	f.set(infoTitleKeys, title)
+}
+
+// Artist returns the artist in IART.
+func (f *InfoChunk) Artist() string {
+	return f.get(infoArtistKeys)
+}
+
+// SetArtist sets the artist to IART.
+func (f *InfoChunk) SetArtist(artist string) {
+	f.set(infoArtistKeys, artist)
+}
+
+// Comment returns the comment in ICMT, CMNT or COMM.
+func (f *InfoChunk) Comment() string {
+	return f.get(infoCommentKeys)
+}
+
+// SetComment sets the comment to ICMT.
+func (f *InfoChunk) SetComment(comment string) {
+	f.set(infoCommentKeys, comment)
+}
+
+// Genre returns the genre in IGNR or GENR.
+func (f *InfoChunk) Genre() string {
+	return f.get(infoGenreKeys)
+}
+
+// SetGenre sets the genre to IGNR.
+func (f *InfoChunk) SetGenre(genre string) {
+	f.set(infoGenreKeys, genre)
+}
+
+// Software returns the name of the software in ISFT.
+func (f *InfoChunk) Software() string {
+	return f.get(infoSoftwareKeys)
+}
+
+// SetSoftware sets the name of the software to ISFT.
+func (f *InfoChunk) SetSoftware(software string) {
+	f.set(infoSoftwareKeys, software)
+}
+
+// DateCreated returns the date in ICRD. It returns false if ICRD is missing or not a date such as "2006-01-02".
+func (f *InfoChunk) DateCreated() (time.Time, bool) {
+	value := strings.TrimSpace(f.get(infoDateCreatedKeys))
+	for _, layout := range infoDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// SetDateCreated sets the date to ICRD in the "2006-01-02" format. The zero time deletes ICRD.
+func (f *InfoChunk) SetDateCreated(t time.Time) {
+	if t.IsZero() {
+		f.set(infoDateCreatedKeys, "")
+		return
+	}
+	f.set(infoDateCreatedKeys, t.Format(infoDateLayouts[0]))
+}
+
+// TrackNumber returns the track number in ITRK or TRCK. The total such as "3/12" is ignored.
+// It returns false if the track number is missing or not a number.
+func (f *InfoChunk) TrackNumber() (int, bool) {
+	value := f.get(infoTrackNumberKeys)
+	if i := strings.IndexByte(value, '/'); i != -1 {
+		value = value[:i]
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// SetTrackNumber sets the track number to ITRK. The non-positive number deletes ITRK and TRCK.
+func (f *InfoChunk) SetTrackNumber(n int) {
+	if n <= 0 {
+		f.set(infoTrackNumberKeys, "")
+		return
+	}
+	f.set(infoTrackNumberKeys, strconv.Itoa(n))
+}
+
+// Languages returns the languages in IAS1 to IAS9 in order.
+func (f *InfoChunk) Languages() []string {
+	var languages []string
+	for _, key := range infoLanguageKeys {
+		if value := f.Data[key]; value != "" {
+			languages = append(languages, value)
+		}
+	}
+	return languages
+}
+
+// SetLanguages sets the languages to IAS1 to IAS9 in order. It returns ErrDataTooLarge for more than 9 languages.
+func (f *InfoChunk) SetLanguages(languages []string) error {
+	if len(languages) > len(infoLanguageKeys) {
+		return fmt.Errorf("INFO.IAS*: %w: %d languages", ErrDataTooLarge, len(languages))
+	}
+
+	for _, key := range infoLanguageKeys {
+		delete(f.Data, key)
+	}
+	for i, language := range languages {
+		if language == "" {
+			continue
+		}
+		if f.Data == nil {
+			f.Data = map[InfoKey]string{}
+		}
+		f.Data[infoLanguageKeys[i]] = language
+	}
+	return nil
+}
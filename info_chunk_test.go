@@ -0,0 +1,301 @@
+package wavebin_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestInfoChunk(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		chunk    *wavebin.InfoChunk
+		expected []riffbin.Chunk
+	}{
+		{
+			name: "Sorted",
+			chunk: &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{
+				wavebin.InfoTitleINAM:   "Title",
+				wavebin.InfoArtistIART:  "AAA",
+				wavebin.InfoCommentICMT: "",
+			}},
+			expected: []riffbin.Chunk{
+				&riffbin.OnMemorySubChunk{ID: wavebin.InfoArtistIART, Payload: []byte("AAA\x00")},
+				&riffbin.OnMemorySubChunk{ID: wavebin.InfoCommentICMT, Payload: []byte("\x00")},
+				&riffbin.OnMemorySubChunk{ID: wavebin.InfoTitleINAM, Payload: []byte("Title\x00")},
+			},
+		},
+		{
+			name: "Order",
+			chunk: &wavebin.InfoChunk{
+				Data: map[wavebin.InfoKey]string{
+					wavebin.InfoTitleINAM:   "Title",
+					wavebin.InfoArtistIART:  "AAA",
+					wavebin.InfoCommentICMT: "Comment",
+				},
+				Order: []wavebin.InfoKey{wavebin.InfoTitleINAM, wavebin.InfoSoftwareISFT, wavebin.InfoTitleINAM},
+			},
+			expected: []riffbin.Chunk{
+				&riffbin.OnMemorySubChunk{ID: wavebin.InfoTitleINAM, Payload: []byte("Title\x00")},
+				&riffbin.OnMemorySubChunk{ID: wavebin.InfoArtistIART, Payload: []byte("AAA\x00")},
+				&riffbin.OnMemorySubChunk{ID: wavebin.InfoCommentICMT, Payload: []byte("Comment\x00")},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			expected := &riffbin.ListChunk{ListType: [4]byte{'I', 'N', 'F', 'O'}, Payload: tt.expected}
+			for i := 0; i < 10; i++ {
+				if diff := cmp.Diff(expected, tt.chunk.Chunk(), cmpopts.IgnoreUnexported(riffbin.OnMemorySubChunk{})); diff != "" {
+					t.Fatalf("unexpected chunk: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestParseWaveChunks_InfoTerminator(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+	}
+	info := &wavebin.RawListChunk{
+		ListType: [4]byte{'I', 'N', 'F', 'O'},
+		Payload: []wavebin.ChunkProvider{
+			&wavebin.RawChunk{ID: wavebin.InfoTitleINAM, Payload: []byte("Title\x00")},
+			&wavebin.RawChunk{ID: wavebin.InfoArtistIART, Payload: []byte("AAA\x00\x00\x00")},
+			&wavebin.RawChunk{ID: wavebin.InfoCommentICMT, Payload: []byte("\x00\x00")},
+		},
+	}
+
+	chunks, err := wavebin.ParseWaveChunks(wavebin.CreateCompletedRIFF(format, []byte{0x80}, info), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[wavebin.InfoKey]string{
+		wavebin.InfoTitleINAM:   "Title",
+		wavebin.InfoArtistIART:  "AAA",
+		wavebin.InfoCommentICMT: "",
+	}
+	if diff := cmp.Diff(expected, chunks.Info.Data); diff != "" {
+		t.Errorf("unexpected data: %s", diff)
+	}
+}
+
+func TestParseWaveChunksWithOptions_InfoText(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+	}
+	info := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoTitleINAM: "caf\xe9 \x80"}}
+
+	for _, tt := range []struct {
+		name     string
+		extras   []wavebin.ChunkProvider
+		decoder  wavebin.TextDecoder
+		expected string
+	}{
+		{
+			name:     "NoCharset",
+			extras:   []wavebin.ChunkProvider{info},
+			expected: "caf\xe9 \x80",
+		},
+		{
+			name:     "Windows1252",
+			extras:   []wavebin.ChunkProvider{info, &wavebin.CharacterSetChunk{CodePage: wavebin.CodePageWindows1252}},
+			expected: "café €",
+		},
+		{
+			name:     "Latin1",
+			extras:   []wavebin.ChunkProvider{&wavebin.CharacterSetChunk{CodePage: wavebin.CodePageLatin1}, info},
+			expected: "café \u0080",
+		},
+		{
+			name:     "UTF8",
+			extras:   []wavebin.ChunkProvider{&wavebin.CharacterSetChunk{CodePage: wavebin.CodePageUTF8}, info},
+			expected: "caf� �",
+		},
+		{
+			name:     "Decoder",
+			extras:   []wavebin.ChunkProvider{&wavebin.CharacterSetChunk{CodePage: wavebin.CodePageUTF8}, info},
+			decoder:  wavebin.DecodeWindows1252,
+			expected: "café €",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			chunks, err := wavebin.ParseWaveChunksWithOptions(wavebin.CreateCompletedRIFF(format, []byte{0x80}, tt.extras...), wavebin.ParseOptions{
+				InfoTextDecoder: tt.decoder,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := chunks.Info.Data[wavebin.InfoTitleINAM]; got != tt.expected {
+				t.Errorf("unexpected title: %q", got)
+			}
+		})
+	}
+}
+
+func TestParseWaveChunks_InfoTextRoundTrip(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+	}
+	info := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoTitleINAM: "caf\xe9 \x80"}}
+
+	for _, tt := range []struct {
+		name     string
+		codePage uint16
+		expected string
+	}{
+		{"Windows1252", wavebin.CodePageWindows1252, "café €"},
+		{"Latin1", wavebin.CodePageLatin1, "café \u0080"},
+		{"Unsupported", 932, "caf\xe9 \x80"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cset := &wavebin.CharacterSetChunk{CodePage: tt.codePage}
+			chunks, err := wavebin.ParseWaveChunks(wavebin.CreateCompletedRIFF(format, []byte{0x80}, cset, info), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := chunks.Info.Data[wavebin.InfoTitleINAM]; got != tt.expected {
+				t.Fatalf("unexpected title: %q", got)
+			}
+
+			// the texts are encoded in the code page of CSET again
+			list := chunks.Info.Chunk().(*riffbin.ListChunk)
+			if df := cmp.Diff([]byte("caf\xe9 \x80\x00"), list.Payload[0].(*riffbin.OnMemorySubChunk).Payload); df != "" {
+				t.Errorf("unexpected payload: %s", df)
+			}
+
+			chunks, err = wavebin.ParseWaveChunks(wavebin.CreateCompletedRIFF(format, []byte{0x80}, chunks.Extras...), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := chunks.Info.Data[wavebin.InfoTitleINAM]; got != tt.expected {
+				t.Errorf("unexpected title after round trip: %q", got)
+			}
+		})
+	}
+
+	t.Run("Unencodable", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			encode   wavebin.TextEncoder
+			expected []byte
+		}{
+			{"UTF8", wavebin.EncodeUTF8, []byte("€ あ")},
+			{"Latin1", wavebin.EncodeLatin1, []byte("? ?")},
+			{"Windows1252", wavebin.EncodeWindows1252, []byte("\x80 ?")},
+		} {
+			if df := cmp.Diff(tt.expected, tt.encode("€ あ")); df != "" {
+				t.Errorf("%s: unexpected bytes: %s", tt.name, df)
+			}
+		}
+	})
+}
+
+func TestInfoChunk_Fields(t *testing.T) {
+	t.Run("Aliases", func(t *testing.T) {
+		info := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{
+			wavebin.InfoTitleTITL:          "Title",
+			wavebin.InfoArtistIART:         "Artist",
+			wavebin.InfoCommentsCOMM:       "Comment",
+			wavebin.InfoGenreGENR:          "Genre",
+			wavebin.InfoSoftwareISFT:       "wavebin",
+			wavebin.InfoDateCreatedICRD:    "2022-04-01",
+			wavebin.InfoTrackNumberTRCK:    "3/12",
+			wavebin.InfoFirstLanguageIAS1:  "English",
+			wavebin.InfoSecondLanguageIAS2: "Japanese",
+		}}
+
+		got := map[string]interface{}{
+			"Title":     info.Title(),
+			"Artist":    info.Artist(),
+			"Comment":   info.Comment(),
+			"Genre":     info.Genre(),
+			"Software":  info.Software(),
+			"Languages": info.Languages(),
+		}
+		expected := map[string]interface{}{
+			"Title":     "Title",
+			"Artist":    "Artist",
+			"Comment":   "Comment",
+			"Genre":     "Genre",
+			"Software":  "wavebin",
+			"Languages": []string{"English", "Japanese"},
+		}
+		if diff := cmp.Diff(expected, got); diff != "" {
+			t.Errorf("unexpected fields: %s", diff)
+		}
+
+		if date, ok := info.DateCreated(); !ok || !date.Equal(time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected date created: %v, %t", date, ok)
+		}
+		if n, ok := info.TrackNumber(); !ok || n != 3 {
+			t.Errorf("unexpected track number: %d, %t", n, ok)
+		}
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		info := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{
+			wavebin.InfoTitleTITL:         "Old",
+			wavebin.InfoCommentCMNT:       "Old",
+			wavebin.InfoCommentsCOMM:      "Old",
+			wavebin.InfoGenreGENR:         "Old",
+			wavebin.InfoTrackNumberTRCK:   "1",
+			wavebin.InfoThirdLanguageIAS3: "Old",
+			wavebin.InfoDateCreatedICRD:   "2000",
+			wavebin.InfoSoftwareISFT:      "Old",
+		}}
+		info.SetTitle("Title")
+		info.SetArtist("Artist")
+		info.SetComment("Comment")
+		info.SetGenre("Genre")
+		info.SetSoftware("")
+		info.SetDateCreated(time.Date(2022, 4, 1, 12, 34, 56, 0, time.UTC))
+		info.SetTrackNumber(3)
+		if err := info.SetLanguages([]string{"English", "Japanese"}); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := map[wavebin.InfoKey]string{
+			wavebin.InfoTitleINAM:          "Title",
+			wavebin.InfoArtistIART:         "Artist",
+			wavebin.InfoCommentICMT:        "Comment",
+			wavebin.InfoGenreIGNR:          "Genre",
+			wavebin.InfoDateCreatedICRD:    "2022-04-01",
+			wavebin.InfoTrackNumberITRK:    "3",
+			wavebin.InfoFirstLanguageIAS1:  "English",
+			wavebin.InfoSecondLanguageIAS2: "Japanese",
+		}
+		if diff := cmp.Diff(expected, info.Data); diff != "" {
+			t.Errorf("unexpected data: %s", diff)
+		}
+
+		if err := info.SetLanguages(make([]string, 10)); !errors.Is(err, wavebin.ErrDataTooLarge) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		info := &wavebin.InfoChunk{}
+		if _, ok := info.DateCreated(); ok {
+			t.Error("date created should be missing")
+		}
+		if _, ok := info.TrackNumber(); ok {
+			t.Error("track number should be missing")
+		}
+
+		info.SetTitle("Title")
+		if diff := cmp.Diff(map[wavebin.InfoKey]string{wavebin.InfoTitleINAM: "Title"}, info.Data); diff != "" {
+			t.Errorf("unexpected data: %s", diff)
+		}
+	})
+}
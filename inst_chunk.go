@@ -0,0 +1,59 @@
+package wavebin
+
+import (
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// instSize is the size of the inst chunk body.
+const instSize = 7
+
+// InstrumentChunk is an inst chunk to describe how to play the samples as an instrument.
+type InstrumentChunk struct {
+	UnshiftedNote uint8 // MIDI note number to play the samples as is
+	FineTune      int8  // pitch shift in cents
+	Gain          int8  // gain in dB
+	LowNote       uint8
+	HighNote      uint8
+	LowVelocity   uint8
+	HighVelocity  uint8
+}
+
+func (c *InstrumentChunk) Bytes() []byte {
+	return []byte{
+		c.UnshiftedNote,
+		byte(c.FineTune),
+		byte(c.Gain),
+		c.LowNote,
+		c.HighNote,
+		c.LowVelocity,
+		c.HighVelocity,
+	}
+}
+
+func (c *InstrumentChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      instBytes,
+		Payload: c.Bytes(),
+	}
+}
+
+func (c *InstrumentChunk) ReadFrom(r io.Reader) (int64, error) {
+	var b [instSize]byte
+	n, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return int64(n), err
+	}
+
+	*c = InstrumentChunk{
+		UnshiftedNote: b[0],
+		FineTune:      int8(b[1]),
+		Gain:          int8(b[2]),
+		LowNote:       b[3],
+		HighNote:      b[4],
+		LowVelocity:   b[5],
+		HighVelocity:  b[6],
+	}
+	return int64(n), nil
+}
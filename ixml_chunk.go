@@ -0,0 +1,176 @@
+package wavebin
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// IXMLChunk is an iXML chunk for the production sound metadata.
+// Raw is the original XML read by ReadFrom, and it's written as is unless Document is changed.
+// Document is empty if Raw cannot be decoded.
+type IXMLChunk struct {
+	Document IXMLDocument
+	Raw      []byte
+
+	// marshaled is Document marshaled on ReadFrom to detect the changes.
+	marshaled []byte
+}
+
+// IXMLDocument is the root BWFXML element of iXML. The elements not in the model are kept in Any.
+type IXMLDocument struct {
+	XMLName   xml.Name       `xml:"BWFXML"`
+	Version   string         `xml:"IXML_VERSION,omitempty"`
+	Project   string         `xml:"PROJECT,omitempty"`
+	Scene     string         `xml:"SCENE,omitempty"`
+	Take      string         `xml:"TAKE,omitempty"`
+	Tape      string         `xml:"TAPE,omitempty"`
+	Circled   IXMLBool       `xml:"CIRCLED,omitempty"`
+	FileUID   string         `xml:"FILE_UID,omitempty"`
+	UBits     string         `xml:"UBITS,omitempty"`
+	Note      string         `xml:"NOTE,omitempty"`
+	Speed     *IXMLSpeed     `xml:"SPEED,omitempty"`
+	TrackList *IXMLTrackList `xml:"TRACK_LIST,omitempty"`
+	Any       []IXMLElement  `xml:",any"`
+}
+
+// IXMLSpeed is the SPEED element of iXML for the timecode.
+type IXMLSpeed struct {
+	Note                            string        `xml:"NOTE,omitempty"`
+	MasterSpeed                     string        `xml:"MASTER_SPEED,omitempty"`
+	CurrentSpeed                    string        `xml:"CURRENT_SPEED,omitempty"`
+	TimecodeRate                    string        `xml:"TIMECODE_RATE,omitempty"`
+	TimecodeFlag                    string        `xml:"TIMECODE_FLAG,omitempty"`
+	FileSampleRate                  uint32        `xml:"FILE_SAMPLE_RATE,omitempty"`
+	AudioBitDepth                   uint16        `xml:"AUDIO_BIT_DEPTH,omitempty"`
+	DigitizerSampleRate             uint32        `xml:"DIGITIZER_SAMPLE_RATE,omitempty"`
+	TimestampSamplesSinceMidnightHi uint32        `xml:"TIMESTAMP_SAMPLES_SINCE_MIDNIGHT_HI"`
+	TimestampSamplesSinceMidnightLo uint32        `xml:"TIMESTAMP_SAMPLES_SINCE_MIDNIGHT_LO"`
+	TimestampSampleRate             uint32        `xml:"TIMESTAMP_SAMPLE_RATE,omitempty"`
+	Any                             []IXMLElement `xml:",any"`
+}
+
+// TimestampSamplesSinceMidnight returns the timestamp in samples combined from HI and LO.
+func (s *IXMLSpeed) TimestampSamplesSinceMidnight() uint64 {
+	return uint64(s.TimestampSamplesSinceMidnightHi)<<32 | uint64(s.TimestampSamplesSinceMidnightLo)
+}
+
+// SetTimestampSamplesSinceMidnight sets the timestamp in samples to HI and LO.
+func (s *IXMLSpeed) SetTimestampSamplesSinceMidnight(samples uint64) {
+	s.TimestampSamplesSinceMidnightHi = uint32(samples >> 32)
+	s.TimestampSamplesSinceMidnightLo = uint32(samples)
+}
+
+// IXMLTrackList is the TRACK_LIST element of iXML.
+type IXMLTrackList struct {
+	TrackCount int           `xml:"TRACK_COUNT"`
+	Tracks     []IXMLTrack   `xml:"TRACK"`
+	Any        []IXMLElement `xml:",any"`
+}
+
+// IXMLTrack is the TRACK element of iXML for the name of the channel.
+type IXMLTrack struct {
+	ChannelIndex    int           `xml:"CHANNEL_INDEX"`
+	InterleaveIndex int           `xml:"INTERLEAVE_INDEX,omitempty"`
+	Name            string        `xml:"NAME,omitempty"`
+	Function        string        `xml:"FUNCTION,omitempty"`
+	Any             []IXMLElement `xml:",any"`
+}
+
+// IXMLElement is the element not in the model to be kept as is.
+type IXMLElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	InnerXML string     `xml:",innerxml"`
+}
+
+// ixmlElement is IXMLElement without MarshalXML to avoid the recursion.
+type ixmlElement IXMLElement
+
+// MarshalXML writes the element as is. The element without name is skipped.
+func (e IXMLElement) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if e.XMLName.Local == "" {
+		return nil
+	}
+
+	start.Name = e.XMLName
+	return enc.EncodeElement(ixmlElement(e), start)
+}
+
+// IXMLBool is the boolean of iXML written as "TRUE" or "FALSE".
+type IXMLBool bool
+
+func (b IXMLBool) MarshalText() ([]byte, error) {
+	if b {
+		return []byte("TRUE"), nil
+	}
+	return []byte("FALSE"), nil
+}
+
+func (b *IXMLBool) UnmarshalText(text []byte) error {
+	*b = IXMLBool(strings.EqualFold(strings.TrimSpace(string(text)), "TRUE"))
+	return nil
+}
+
+// Bytes returns Raw if Document is not changed or cannot be marshaled, otherwise the marshaled Document.
+func (c *IXMLChunk) Bytes() []byte {
+	b, err := xml.MarshalIndent(&c.Document, "", "\t")
+	if err != nil || (c.Raw != nil && bytes.Equal(b, c.marshaled)) {
+		return c.Raw
+	}
+
+	return append([]byte(xml.Header), b...)
+}
+
+func (c *IXMLChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      ixmlBytes,
+		Payload: c.Bytes(),
+	}
+}
+
+func (c *IXMLChunk) ReadFrom(r io.Reader) (int64, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return int64(len(raw)), err
+	}
+
+	var doc IXMLDocument
+	d := xml.NewDecoder(bytes.NewReader(bytes.TrimRight(raw, "\x00")))
+	d.CharsetReader = ixmlCharsetReader
+	if err := d.Decode(&doc); err != nil {
+		// keep the XML as is
+		doc = IXMLDocument{}
+	}
+
+	marshaled, err := xml.MarshalIndent(&doc, "", "\t")
+	if err != nil {
+		return int64(len(raw)), err
+	}
+
+	*c = IXMLChunk{Document: doc, Raw: raw, marshaled: marshaled}
+	return int64(len(raw)), nil
+}
+
+// ixmlCharsetReader converts the iXML in ISO-8859-1 or Windows-1252 into UTF-8.
+func ixmlCharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	var decode TextDecoder
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "latin1":
+		decode = DecodeLatin1
+	case "windows-1252", "cp1252":
+		decode = DecodeWindows1252
+	default:
+		return nil, fmt.Errorf("unsupported charset: %s", charset)
+	}
+
+	b, err := io.ReadAll(input)
+	if err != nil {
+		return nil, err
+	}
+	return strings.NewReader(decode(b)), nil
+}
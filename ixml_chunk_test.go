@@ -0,0 +1,226 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/karupanerura/wavebin"
+)
+
+const ixmlSample = `<?xml version="1.0" encoding="UTF-8"?>
+<BWFXML>
+  <IXML_VERSION>2.10</IXML_VERSION>
+  <PROJECT>wavebin</PROJECT>
+  <SCENE>12A</SCENE>
+  <TAKE>3</TAKE>
+  <TAPE>DAY01</TAPE>
+  <CIRCLED>TRUE</CIRCLED>
+  <SPEED>
+    <MASTER_SPEED>24/1</MASTER_SPEED>
+    <TIMECODE_RATE>24/1</TIMECODE_RATE>
+    <TIMECODE_FLAG>NDF</TIMECODE_FLAG>
+    <FILE_SAMPLE_RATE>48000</FILE_SAMPLE_RATE>
+    <TIMESTAMP_SAMPLES_SINCE_MIDNIGHT_HI>1</TIMESTAMP_SAMPLES_SINCE_MIDNIGHT_HI>
+    <TIMESTAMP_SAMPLES_SINCE_MIDNIGHT_LO>2</TIMESTAMP_SAMPLES_SINCE_MIDNIGHT_LO>
+  </SPEED>
+  <TRACK_LIST>
+    <TRACK_COUNT>2</TRACK_COUNT>
+    <TRACK><CHANNEL_INDEX>1</CHANNEL_INDEX><INTERLEAVE_INDEX>1</INTERLEAVE_INDEX><NAME>Boom</NAME></TRACK>
+    <TRACK><CHANNEL_INDEX>2</CHANNEL_INDEX><INTERLEAVE_INDEX>2</INTERLEAVE_INDEX><NAME>Lav</NAME><VENDOR_GAIN>-3</VENDOR_GAIN></TRACK>
+  </TRACK_LIST>
+  <HISTORY><ORIGINAL_FILENAME>T003.WAV</ORIGINAL_FILENAME></HISTORY>
+</BWFXML>
+` + "\x00"
+
+func TestIXMLChunk(t *testing.T) {
+	var chunk wavebin.IXMLChunk
+	if _, err := chunk.ReadFrom(bytes.NewReader([]byte(ixmlSample))); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := wavebin.IXMLDocument{
+		XMLName: xml.Name{Local: "BWFXML"},
+		Version: "2.10",
+		Project: "wavebin",
+		Scene:   "12A",
+		Take:    "3",
+		Tape:    "DAY01",
+		Circled: true,
+		Speed: &wavebin.IXMLSpeed{
+			MasterSpeed:                     "24/1",
+			TimecodeRate:                    "24/1",
+			TimecodeFlag:                    "NDF",
+			FileSampleRate:                  48000,
+			TimestampSamplesSinceMidnightHi: 1,
+			TimestampSamplesSinceMidnightLo: 2,
+		},
+		TrackList: &wavebin.IXMLTrackList{
+			TrackCount: 2,
+			Tracks: []wavebin.IXMLTrack{
+				{ChannelIndex: 1, InterleaveIndex: 1, Name: "Boom"},
+				{ChannelIndex: 2, InterleaveIndex: 2, Name: "Lav", Any: []wavebin.IXMLElement{
+					{XMLName: xml.Name{Local: "VENDOR_GAIN"}, InnerXML: "-3"},
+				}},
+			},
+		},
+		Any: []wavebin.IXMLElement{
+			{XMLName: xml.Name{Local: "HISTORY"}, InnerXML: "<ORIGINAL_FILENAME>T003.WAV</ORIGINAL_FILENAME>"},
+		},
+	}
+	if diff := cmp.Diff(expected, chunk.Document); diff != "" {
+		t.Errorf("unexpected document: %s", diff)
+	}
+	if got := chunk.Document.Speed.TimestampSamplesSinceMidnight(); got != 1<<32|2 {
+		t.Errorf("unexpected timestamp: %d", got)
+	}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		if got := chunk.Bytes(); !bytes.Equal([]byte(ixmlSample), got) {
+			t.Errorf("unexpected bytes: %q", got)
+		}
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		changed := chunk
+		changed.Document.Take = "4"
+
+		b := changed.Bytes()
+		if bytes.Equal([]byte(ixmlSample), b) {
+			t.Fatal("should be marshaled again")
+		}
+
+		var got wavebin.IXMLChunk
+		if _, err := got.ReadFrom(changed.Chunk().(io.Reader)); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := expected
+		expected.Take = "4"
+		if diff := cmp.Diff(expected, got.Document); diff != "" {
+			t.Errorf("unexpected document: %s", diff)
+		}
+	})
+
+	t.Run("New", func(t *testing.T) {
+		chunk := &wavebin.IXMLChunk{Document: wavebin.IXMLDocument{Scene: "1", Take: "1"}}
+		chunk.Document.Speed = &wavebin.IXMLSpeed{}
+		chunk.Document.Speed.SetTimestampSamplesSinceMidnight(48000 * 3600)
+
+		var got wavebin.IXMLChunk
+		if _, err := got.ReadFrom(chunk.Chunk().(io.Reader)); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := wavebin.IXMLDocument{
+			XMLName: xml.Name{Local: "BWFXML"},
+			Scene:   "1",
+			Take:    "1",
+			Speed:   &wavebin.IXMLSpeed{TimestampSamplesSinceMidnightLo: 48000 * 3600},
+		}
+		if diff := cmp.Diff(expected, got.Document); diff != "" {
+			t.Errorf("unexpected document: %s", diff)
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		format := &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		}
+		raw := &wavebin.RawChunk{ID: [4]byte{'i', 'X', 'M', 'L'}, Payload: []byte(ixmlSample)}
+		chunks, err := wavebin.ParseWaveChunks(wavebin.CreateCompletedRIFF(format, []byte{0x80}, raw), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(&chunk, chunks.IXML, cmpopts.IgnoreUnexported(wavebin.IXMLChunk{})); diff != "" {
+			t.Errorf("unexpected iXML chunk: %s", diff)
+		}
+	})
+
+	t.Run("OddRaw", func(t *testing.T) {
+		format := &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		}
+		raw := []byte("<BWFXML><SCENE>1</SCENE></BWFXML>")
+		bext := &wavebin.BextChunk{Description: "desc"}
+
+		var src wavebin.IXMLChunk
+		if _, err := src.ReadFrom(bytes.NewReader(raw)); err != nil {
+			t.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+		w, err := wavebin.CreateStreamingSampleWriter(&buf, 0, format, &src, bext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		decoder, err := wavebin.NewDecoder(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := decoder.Chunks().IXML.Bytes(); !bytes.Equal(raw, got) {
+			t.Errorf("unexpected bytes: %q", got)
+		}
+		if diff := cmp.Diff(bext, decoder.Chunks().Bext); diff != "" {
+			t.Errorf("unexpected bext: %s", diff)
+		}
+	})
+}
+
+func TestIXMLChunk_Invalid(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+	}
+
+	t.Run("Latin1", func(t *testing.T) {
+		raw := []byte("<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n<BWFXML><PROJECT>caf\xe9</PROJECT></BWFXML>\n")
+		chunks, err := wavebin.ParseWaveChunks(wavebin.CreateCompletedRIFF(format, []byte{0x80}, &wavebin.RawChunk{ID: [4]byte{'i', 'X', 'M', 'L'}, Payload: raw}), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if chunks.IXML.Document.Project != "café" {
+			t.Errorf("unexpected project: %q", chunks.IXML.Document.Project)
+		}
+		if got := chunks.IXML.Bytes(); !bytes.Equal(raw, got) {
+			t.Errorf("unexpected bytes: %q", got)
+		}
+	})
+
+	t.Run("Broken", func(t *testing.T) {
+		raw := []byte("<BWFXML><SCENE>1</BWFXML>")
+		chunks, err := wavebin.ParseWaveChunks(wavebin.CreateCompletedRIFF(format, []byte{0x80}, &wavebin.RawChunk{ID: [4]byte{'i', 'X', 'M', 'L'}, Payload: raw}), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(wavebin.IXMLDocument{}, chunks.IXML.Document); diff != "" {
+			t.Errorf("unexpected document: %s", diff)
+		}
+		if got := chunks.IXML.Bytes(); !bytes.Equal(raw, got) {
+			t.Errorf("unexpected bytes: %q", got)
+		}
+	})
+
+	t.Run("NoName", func(t *testing.T) {
+		chunk := &wavebin.IXMLChunk{Document: wavebin.IXMLDocument{
+			Scene: "1",
+			Any:   []wavebin.IXMLElement{{InnerXML: "ignored"}},
+		}}
+
+		var got wavebin.IXMLChunk
+		if _, err := got.ReadFrom(bytes.NewReader(chunk.Bytes())); err != nil {
+			t.Fatal(err)
+		}
+		expected := wavebin.IXMLDocument{XMLName: xml.Name{Local: "BWFXML"}, Scene: "1"}
+		if diff := cmp.Diff(expected, got.Document); diff != "" {
+			t.Errorf("unexpected document: %s", diff)
+		}
+	})
+}
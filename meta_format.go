@@ -6,7 +6,10 @@ type CompressionCode uint16
 
 const (
 	pcmCompressionCode        CompressionCode = 0x0001
+	adpcmCompressionCode      CompressionCode = 0x0002
 	ieeeFloatCompressionCode  CompressionCode = 0x0003
+	aLawCompressionCode       CompressionCode = 0x0006
+	muLawCompressionCode      CompressionCode = 0x0007
 	extensibleCompressionCode CompressionCode = 0xFFFE
 )
 
@@ -162,14 +165,68 @@ const (
 	ChannelMaskTopBackRight       ChannelMask = 0x00020000
 )
 
+// SubFormat is the GUID of the sub-format of WAVE_FORMAT_EXTENSIBLE.
+type SubFormat [16]byte
+
+// Well-known KSDATAFORMAT_SUBTYPE_* GUIDs.
+var (
+	SubFormatPCM       = newKSDataFormatSubFormat(pcmCompressionCode)
+	SubFormatADPCM     = newKSDataFormatSubFormat(adpcmCompressionCode)
+	SubFormatIEEEFloat = newKSDataFormatSubFormat(ieeeFloatCompressionCode)
+	SubFormatALaw      = newKSDataFormatSubFormat(aLawCompressionCode)
+	SubFormatMULaw     = newKSDataFormatSubFormat(muLawCompressionCode)
+)
+
+// newKSDataFormatSubFormat creates the GUID {XXXXXXXX-0000-0010-8000-00AA00389B71} from the compression code.
+func newKSDataFormatSubFormat(code CompressionCode) SubFormat {
+	return SubFormat{
+		byte(code), byte(code >> 8), 0x00, 0x00,
+		0x00, 0x00,
+		0x10, 0x00,
+		0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71,
+	}
+}
+
+// CompressionCode returns the compression code of the sub-format.
+// It returns false if the GUID is not derived from the KSDATAFORMAT_SUBTYPE base GUID.
+func (s SubFormat) CompressionCode() (uint16, bool) {
+	code := binary.LittleEndian.Uint16(s[:2])
+	if newKSDataFormatSubFormat(CompressionCode(code)) != s {
+		return 0, false
+	}
+
+	return code, true
+}
+
+// EffectiveCompressionCode returns the compression code of the format.
+// For WAVE_FORMAT_EXTENSIBLE, it returns the compression code resolved from the sub-format if possible.
+func EffectiveCompressionCode(format MetaFormat) uint16 {
+	code := format.CompressionCode()
+	if CompressionCode(code) != extensibleCompressionCode {
+		return code
+	}
+
+	ef := format.ExtraField()
+	if len(ef) < 22 {
+		return code
+	}
+
+	var subFormat SubFormat
+	copy(subFormat[:], ef[6:22])
+	if subCode, ok := subFormat.CompressionCode(); ok {
+		return subCode
+	}
+	return code
+}
+
 type ExtensibleMetaFormat struct {
 	commonMetaFormat
 	validBitsPerSample ValidBitsPerSample
 	channelMask        ChannelMask
-	subFormat          [16]byte
+	subFormat          SubFormat
 }
 
-func NewExtensibleMetaFormat(channels Channels, samplesPerSecond SamplesPerSecond, significantBitsPerSample SignificantBitsPerSample, validBitsPerSample ValidBitsPerSample, channelMask ChannelMask, subFormat [16]byte) *ExtensibleMetaFormat {
+func NewExtensibleMetaFormat(channels Channels, samplesPerSecond SamplesPerSecond, significantBitsPerSample SignificantBitsPerSample, validBitsPerSample ValidBitsPerSample, channelMask ChannelMask, subFormat SubFormat) *ExtensibleMetaFormat {
 	return &ExtensibleMetaFormat{
 		commonMetaFormat: commonMetaFormat{
 			channels:                 channels,
@@ -186,6 +243,18 @@ func (f *ExtensibleMetaFormat) CompressionCode() uint16 {
 	return uint16(extensibleCompressionCode)
 }
 
+func (f *ExtensibleMetaFormat) ValidBitsPerSample() ValidBitsPerSample {
+	return f.validBitsPerSample
+}
+
+func (f *ExtensibleMetaFormat) ChannelMask() ChannelMask {
+	return f.channelMask
+}
+
+func (f *ExtensibleMetaFormat) SubFormat() SubFormat {
+	return f.subFormat
+}
+
 func (f *ExtensibleMetaFormat) ExtraField() (b []byte) {
 	b = make([]byte, 22)
 	binary.LittleEndian.PutUint16(b[:2], uint16(f.validBitsPerSample))
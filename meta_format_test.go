@@ -16,3 +16,48 @@ func TestPCMMetaFormat(t *testing.T) {
 		t.Errorf("BlockAlign should be 4 but got: %d", f.BlockAlign())
 	}
 }
+
+func TestSubFormat_CompressionCode(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		subFormat wavebin.SubFormat
+		code      uint16
+		ok        bool
+	}{
+		{"PCM", wavebin.SubFormatPCM, 0x0001, true},
+		{"ADPCM", wavebin.SubFormatADPCM, 0x0002, true},
+		{"IEEEFloat", wavebin.SubFormatIEEEFloat, 0x0003, true},
+		{"ALaw", wavebin.SubFormatALaw, 0x0006, true},
+		{"MULaw", wavebin.SubFormatMULaw, 0x0007, true},
+		{"Unknown", wavebin.SubFormat{0x01, 0x02, 0x03, 0x04}, 0, false},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			code, ok := tt.subFormat.CompressionCode()
+			if code != tt.code || ok != tt.ok {
+				t.Errorf("CompressionCode should be (0x%04X, %t) but got: (0x%04X, %t)", tt.code, tt.ok, code, ok)
+			}
+		})
+	}
+}
+
+func TestEffectiveCompressionCode(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		format wavebin.MetaFormat
+		code   uint16
+	}{
+		{"PCM", wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16), 0x0001},
+		{"IEEEFloat", wavebin.NewIEEEFloatMetaFormat(wavebin.StereoChannels, 44100, 32), 0x0003},
+		{"ExtensiblePCM", wavebin.NewExtensibleMetaFormat(wavebin.StereoChannels, 44100, 16, 16, 0x3, wavebin.SubFormatPCM), 0x0001},
+		{"ExtensibleIEEEFloat", wavebin.NewExtensibleMetaFormat(wavebin.StereoChannels, 44100, 32, 32, 0x3, wavebin.SubFormatIEEEFloat), 0x0003},
+		{"ExtensibleUnknown", wavebin.NewExtensibleMetaFormat(wavebin.StereoChannels, 44100, 16, 16, 0x3, wavebin.SubFormat{0x01}), 0xFFFE},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if code := wavebin.EffectiveCompressionCode(tt.format); code != tt.code {
+				t.Errorf("EffectiveCompressionCode should be 0x%04X but got: 0x%04X", tt.code, code)
+			}
+		})
+	}
+}
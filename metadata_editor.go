@@ -0,0 +1,333 @@
+package wavebin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// MetadataEditor edits the metadata chunks of the WAVE file in place without rewriting the samples.
+// The new chunk is written to the JUNK chunks if there is room, otherwise it is appended to the end of the RIFF chunk.
+// Then the old chunk is turned into a JUNK chunk to be reused by the next edit.
+type MetadataEditor struct {
+	rw   io.ReadWriteSeeker
+	ra   io.ReaderAt
+	id   [4]byte
+	head int64
+	end  int64
+
+	// ds64Offset is the offset of the ds64 chunk body for RF64 and BW64.
+	ds64Offset int64
+	entries    []editorEntry
+}
+
+// editorEntry is the position of the chunk in the RIFF chunk.
+type editorEntry struct {
+	name string
+	off  int64
+	size int64
+
+	// padded is true if the odd-sized chunk is followed by the pad byte.
+	padded bool
+}
+
+func (e *editorEntry) isJunk() bool {
+	return e.name == string(junkBytes[:]) || e.name == string(upperJunkBytes[:])
+}
+
+func (e *editorEntry) end() int64 {
+	if e.padded {
+		return e.off + riffbin.HeaderBytes + e.size + 1
+	}
+	return e.off + riffbin.HeaderBytes + e.size
+}
+
+// NewMetadataEditor scans the chunks of the RIFF, RF64 or BW64 WAVE binary from the current position of rw.
+// It returns an error if the sizes of the chunks are broken or unknown. Use RepairWave for such a file in advance.
+func NewMetadataEditor(rw io.ReadWriteSeeker) (*MetadataEditor, error) {
+	head, err := rw.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, fmt.Errorf("seek: %w", err)
+	}
+
+	ra, ok := rw.(io.ReaderAt)
+	if !ok {
+		ra = &seekReaderAt{r: rw}
+	}
+
+	e := &MetadataEditor{rw: rw, ra: ra, head: head}
+	if err := e.scan(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Set replaces the top-level chunk of the same ID (or the same list type for LIST chunk) with the chunk, or adds it.
+// The old chunk is kept if the new chunk cannot be written.
+// *InfoChunk of zero CodePage is encoded in the character set of the CSET chunk in the file, so set it again after setting CSET chunk.
+// fmt, data, ds64 and JUNK chunks cannot be set.
+func (e *MetadataEditor) Set(chunk ChunkProvider) error {
+	if info, ok := chunk.(*InfoChunk); ok && info.CodePage == 0 {
+		codePage, err := e.codePage()
+		if err != nil {
+			return err
+		}
+		if codePage != 0 {
+			encoded := *info
+			encoded.CodePage = codePage
+			chunk = &encoded
+		}
+	}
+
+	c := chunk.Chunk()
+	name := chunkName(c)
+	if err := checkEditableChunk(name); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if _, err := writeChunk(&buf, c); err != nil {
+		return fmt.Errorf("%s[WAVE].%s: %w", string(e.id[:]), name, err)
+	}
+	b := buf.Bytes()
+
+	// write the new chunk before removing the old one not to lose it on failure
+	if off, size, ok := e.findFreeSpace(int64(len(b))); ok {
+		if err := writeBytesAt(e.rw, off, b); err != nil {
+			return fmt.Errorf("%s[WAVE].%s: %w", string(e.id[:]), name, err)
+		}
+		if rest := size - int64(len(b)); rest != 0 {
+			var junk bytes.Buffer
+			if _, err := writeChunkHeader(&junk, upperJunkBytes[:], uint32(rest-riffbin.HeaderBytes)); err != nil {
+				return err
+			}
+			if err := writeBytesAt(e.rw, off+int64(len(b)), junk.Bytes()); err != nil {
+				return fmt.Errorf("%s[WAVE].JUNK: %w", string(e.id[:]), err)
+			}
+		}
+	} else if err := e.append(name, b); err != nil {
+		return err
+	}
+
+	// the written chunk is not in the entries yet, so only the old chunks are removed
+	if err := e.remove(name); err != nil {
+		return err
+	}
+
+	return e.scan()
+}
+
+// Remove turns the top-level chunks of the name into JUNK chunks. The name is the chunk ID such as "bext" or "LIST[INFO]" for LIST chunk.
+// fmt, data, ds64 and JUNK chunks cannot be removed.
+func (e *MetadataEditor) Remove(name string) error {
+	if err := checkEditableChunk(name); err != nil {
+		return err
+	}
+
+	if err := e.remove(name); err != nil {
+		return err
+	}
+
+	return e.scan()
+}
+
+func checkEditableChunk(name string) error {
+	switch name {
+	case string(fmtBytes[:]), string(dataBytes[:]), string(ds64Bytes[:]), string(junkBytes[:]), string(upperJunkBytes[:]):
+		return fmt.Errorf("%s: %w: not editable", name, ErrUnexpectedChunkType)
+	default:
+		return nil
+	}
+}
+
+// remove turns the chunks of the name into JUNK chunks and clears their bodies.
+func (e *MetadataEditor) remove(name string) error {
+	for i := range e.entries {
+		entry := &e.entries[i]
+		if entry.name != name {
+			continue
+		}
+
+		if err := writeBytesAt(e.rw, entry.off, upperJunkBytes[:]); err != nil {
+			return fmt.Errorf("%s[WAVE].%s: %w", string(e.id[:]), name, err)
+		}
+		if err := writeBytesAt(e.rw, entry.off+riffbin.HeaderBytes, make([]byte, entry.size)); err != nil {
+			return fmt.Errorf("%s[WAVE].%s: %w", string(e.id[:]), name, err)
+		}
+		entry.name = string(upperJunkBytes[:])
+	}
+
+	return nil
+}
+
+// findFreeSpace finds the consecutive JUNK chunks to write the chunk of the size.
+// The rest of the space must be large enough for the header of the new JUNK chunk.
+func (e *MetadataEditor) findFreeSpace(size int64) (off, space int64, ok bool) {
+	for i := 0; i < len(e.entries); i++ {
+		if !e.entries[i].isJunk() || e.isReservedJunk(&e.entries[i]) {
+			continue
+		}
+
+		off = e.entries[i].off
+		for j := i; j < len(e.entries) && e.entries[j].isJunk(); j++ {
+			space = e.entries[j].end() - off
+			if space == size || space >= size+riffbin.HeaderBytes {
+				return off, space, true
+			}
+		}
+	}
+
+	return 0, 0, false
+}
+
+// codePage returns the code page of the CSET chunk in the file. It returns zero if there is no CSET chunk.
+func (e *MetadataEditor) codePage() (uint16, error) {
+	for _, entry := range e.entries {
+		if entry.name != string(csetBytes[:]) {
+			continue
+		}
+		if entry.size < csetSize {
+			return 0, fmt.Errorf("%s[WAVE].CSET: %w", string(e.id[:]), ErrUnexpectedChunkSize)
+		}
+
+		var cset CharacterSetChunk
+		if _, err := cset.ReadFrom(io.NewSectionReader(e.ra, entry.off+riffbin.HeaderBytes, entry.size)); err != nil {
+			return 0, fmt.Errorf("%s[WAVE].CSET: %w", string(e.id[:]), invalidFormatError(err))
+		}
+		return cset.CodePage, nil
+	}
+
+	return 0, nil
+}
+
+// isReservedJunk returns true if the JUNK chunk is reserved for ds64 chunk by CreateRF64SampleWriter.
+func (e *MetadataEditor) isReservedJunk(entry *editorEntry) bool {
+	return e.id == riffBytes && entry.off == e.head+riffbin.HeaderBytes+4 && entry.size == ds64BodySize
+}
+
+// append writes the chunk at the end of the RIFF chunk and updates the size of the RIFF chunk.
+// The pad byte is written before the chunk if the last chunk is odd-sized without the pad byte.
+func (e *MetadataEditor) append(name string, b []byte) error {
+	if (e.end-e.head)%2 != 0 {
+		b = append([]byte{0}, b...)
+	}
+
+	riffSize := uint64(e.end-e.head-riffbin.HeaderBytes) + uint64(len(b))
+	if e.id == riffBytes && riffSize > maxRIFFSize {
+		return fmt.Errorf("RIFF[WAVE].%s: %w", name, ErrDataTooLarge)
+	}
+
+	if err := writeBytesAt(e.rw, e.end, b); err != nil {
+		return fmt.Errorf("%s[WAVE].%s: %w", string(e.id[:]), name, err)
+	}
+
+	if e.id == riffBytes {
+		if err := writeUint32At(e.rw, e.head+4, uint32(riffSize)); err != nil {
+			return fmt.Errorf("RIFF: %w", err)
+		}
+		return nil
+	}
+
+	var size [8]byte
+	binary.LittleEndian.PutUint64(size[:], riffSize)
+	if err := writeBytesAt(e.rw, e.ds64Offset, size[:]); err != nil {
+		return fmt.Errorf("%s[WAVE].ds64: %w", string(e.id[:]), err)
+	}
+	if err := writeUint32At(e.rw, e.head+4, rf64PlaceholderSize); err != nil {
+		return fmt.Errorf("%s: %w", string(e.id[:]), err)
+	}
+	return nil
+}
+
+// scan reads the headers of the top-level chunks.
+func (e *MetadataEditor) scan() error {
+	var b [riffbin.HeaderBytes + 4]byte
+	if _, err := e.ra.ReadAt(b[:], e.head); err != nil {
+		return invalidFormatError(err)
+	}
+
+	copy(e.id[:], b[:4])
+	if formType := b[8:]; !bytes.Equal(formType, waveBytes[:]) {
+		return fmt.Errorf("%w: %s", ErrUnexpectedFormType, string(formType))
+	}
+
+	size32 := binary.LittleEndian.Uint32(b[4:8])
+	riffSize := uint64(size32)
+	var ds64 *DataSize64Chunk
+	switch e.id {
+	case riffBytes:
+		if size32 == rf64PlaceholderSize {
+			return fmt.Errorf("RIFF: %w: unknown size", ErrUnexpectedChunkSize)
+		}
+	case rf64Bytes, bw64Bytes:
+		var h [riffbin.HeaderBytes]byte
+		if _, err := e.ra.ReadAt(h[:], e.head+int64(len(b))); err != nil {
+			return invalidFormatError(err)
+		}
+		if !bytes.Equal(h[:4], ds64Bytes[:]) || binary.LittleEndian.Uint32(h[4:]) < ds64BodySize {
+			return fmt.Errorf("%s[WAVE].ds64: %w", string(e.id[:]), ErrLackOfRequiredChunks)
+		}
+
+		e.ds64Offset = e.head + int64(len(b)) + riffbin.HeaderBytes
+		ds64 = &DataSize64Chunk{}
+		if _, err := ds64.ReadFrom(io.NewSectionReader(e.ra, e.ds64Offset, int64(binary.LittleEndian.Uint32(h[4:])))); err != nil {
+			return fmt.Errorf("%s[WAVE].ds64: %w", string(e.id[:]), invalidFormatError(err))
+		}
+		if size32 == rf64PlaceholderSize {
+			riffSize = ds64.RIFFSize
+		}
+	default:
+		return fmt.Errorf("%w: %s", riffbin.ErrInvalidFormat, string(e.id[:]))
+	}
+
+	e.end = e.head + riffbin.HeaderBytes + int64(riffSize)
+	e.entries = e.entries[:0]
+	for pos := e.head + int64(len(b)); pos < e.end; {
+		var h [riffbin.HeaderBytes + 4]byte
+		n, err := e.ra.ReadAt(h[:], pos)
+		if n < riffbin.HeaderBytes {
+			return invalidFormatError(err)
+		}
+		if pos+riffbin.HeaderBytes > e.end {
+			return fmt.Errorf("%s[WAVE]: %w: broken chunk header", string(e.id[:]), riffbin.ErrInvalidFormat)
+		}
+
+		var id [4]byte
+		copy(id[:], h[:4])
+		size32 := binary.LittleEndian.Uint32(h[4:8])
+		size := uint64(size32)
+		if size32 == rf64PlaceholderSize {
+			if ds64 == nil {
+				return fmt.Errorf("%s[WAVE].%s: %w: unknown size", string(e.id[:]), string(id[:]), ErrUnexpectedChunkSize)
+			}
+			if s, ok := ds64.chunkSize(id); ok {
+				size = s
+			}
+		}
+
+		entry := editorEntry{name: string(id[:]), off: pos, size: int64(size)}
+		if id == listBytes && n == len(h) && size >= 4 {
+			entry.name = fmt.Sprintf("LIST[%s]", string(h[8:]))
+		}
+		if entry.end() > e.end {
+			return fmt.Errorf("%s[WAVE].%s: %w: %d bytes exceeds the RIFF chunk", string(e.id[:]), entry.name, ErrUnexpectedChunkSize, size)
+		}
+		if size%2 != 0 && entry.end() < e.end {
+			// the pad byte is NUL, but some writers omit it and then the next chunk ID follows
+			var pad [1]byte
+			if _, err := e.ra.ReadAt(pad[:], entry.end()); err != nil {
+				return invalidFormatError(err)
+			}
+			entry.padded = pad[0] == 0
+		}
+
+		e.entries = append(e.entries, entry)
+		pos = entry.end()
+	}
+
+	return nil
+}
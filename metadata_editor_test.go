@@ -0,0 +1,371 @@
+package wavebin_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestMetadataEditor(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+	}
+	samples := []byte{0x00, 0x80, 0xff, 0x7f, 0x00, 0x40, 0x00, 0x00}
+	info := func(artist string) *wavebin.InfoChunk {
+		return &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoArtistIART: artist}}
+	}
+	bext := &wavebin.BextChunk{Description: "desc", Originator: "wavebin"}
+	// writtenSize returns the size of the chunk with the pad bytes as written by the writers
+	var writtenSize func(c riffbin.Chunk) int64
+	writtenSize = func(c riffbin.Chunk) int64 {
+		size := int64(c.BodySize())
+		if l, ok := c.(*riffbin.ListChunk); ok {
+			size = 4
+			for _, p := range l.Payload {
+				size += writtenSize(p)
+			}
+		}
+		return 8 + size + size%2
+	}
+	chunkSize := func(c wavebin.ChunkProvider) int64 { return writtenSize(c.Chunk()) }
+
+	for _, tt := range []struct {
+		name         string
+		create       func(io.WriteSeeker, wavebin.FormatChunk, ...wavebin.ChunkProvider) (io.WriteCloser, error)
+		maxRIFFSize  uint64
+		extras       []wavebin.ChunkProvider
+		edit         func(e *wavebin.MetadataEditor) error
+		expectedInfo *wavebin.InfoChunk
+		expectedBext *wavebin.BextChunk
+		expectedGrow int64
+	}{
+		{
+			name:         "SameSize",
+			create:       wavebin.CreateSampleWriter,
+			extras:       []wavebin.ChunkProvider{info("AAAA")},
+			edit:         func(e *wavebin.MetadataEditor) error { return e.Set(info("BBBB")) },
+			expectedInfo: info("BBBB"),
+			expectedGrow: chunkSize(info("BBBB")),
+		},
+		{
+			name:         "Smaller",
+			create:       wavebin.CreateSampleWriter,
+			extras:       []wavebin.ChunkProvider{info(strings.Repeat("A", 16))},
+			edit:         func(e *wavebin.MetadataEditor) error { return e.Set(info("B")) },
+			expectedInfo: info("B"),
+			expectedGrow: chunkSize(info("B")),
+		},
+		{
+			name:   "ReuseRemoved",
+			create: wavebin.CreateSampleWriter,
+			extras: []wavebin.ChunkProvider{info("AAAA")},
+			edit: func(e *wavebin.MetadataEditor) error {
+				if err := e.Set(info("BBBB")); err != nil {
+					return err
+				}
+				return e.Set(info("CCCC"))
+			},
+			expectedInfo: info("CCCC"),
+			expectedGrow: chunkSize(info("BBBB")),
+		},
+		{
+			name:   "CharacterSet",
+			create: wavebin.CreateSampleWriter,
+			extras: []wavebin.ChunkProvider{
+				&wavebin.CharacterSetChunk{CodePage: wavebin.CodePageWindows1252},
+				&wavebin.RawChunk{ID: [4]byte{'J', 'U', 'N', 'K'}, Payload: make([]byte, 100)},
+			},
+			edit: func(e *wavebin.MetadataEditor) error { return e.Set(info("€")) },
+			expectedInfo: &wavebin.InfoChunk{
+				Data:     map[wavebin.InfoKey]string{wavebin.InfoArtistIART: "€"},
+				CodePage: wavebin.CodePageWindows1252,
+			},
+			expectedGrow: 0,
+		},
+		{
+			name:         "Larger",
+			create:       wavebin.CreateSampleWriter,
+			extras:       []wavebin.ChunkProvider{info("A")},
+			edit:         func(e *wavebin.MetadataEditor) error { return e.Set(info(strings.Repeat("B", 64))) },
+			expectedInfo: info(strings.Repeat("B", 64)),
+			expectedGrow: chunkSize(info(strings.Repeat("B", 64))),
+		},
+		{
+			name:   "LargerWithJunk",
+			create: wavebin.CreateSampleWriter,
+			extras: []wavebin.ChunkProvider{
+				info("A"),
+				&wavebin.RawChunk{ID: [4]byte{'J', 'U', 'N', 'K'}, Payload: make([]byte, 100)},
+			},
+			edit:         func(e *wavebin.MetadataEditor) error { return e.Set(info(strings.Repeat("B", 64))) },
+			expectedInfo: info(strings.Repeat("B", 64)),
+			expectedGrow: 0,
+		},
+		{
+			name:         "Add",
+			create:       wavebin.CreateSampleWriter,
+			extras:       []wavebin.ChunkProvider{info("A")},
+			edit:         func(e *wavebin.MetadataEditor) error { return e.Set(bext) },
+			expectedInfo: info("A"),
+			expectedBext: bext,
+			expectedGrow: chunkSize(bext),
+		},
+		{
+			name:         "Remove",
+			create:       wavebin.CreateSampleWriter,
+			extras:       []wavebin.ChunkProvider{info("A"), bext},
+			edit:         func(e *wavebin.MetadataEditor) error { return e.Remove("LIST[INFO]") },
+			expectedBext: bext,
+			expectedGrow: 0,
+		},
+		{
+			name:         "ReservedJunk",
+			create:       wavebin.CreateRF64SampleWriter,
+			maxRIFFSize:  0xFFFFFFFF,
+			extras:       []wavebin.ChunkProvider{info("A")},
+			edit:         func(e *wavebin.MetadataEditor) error { return e.Set(bext) },
+			expectedInfo: info("A"),
+			expectedBext: bext,
+			expectedGrow: chunkSize(bext),
+		},
+		{
+			name:         "RF64",
+			create:       wavebin.CreateRF64SampleWriter,
+			maxRIFFSize:  64,
+			extras:       []wavebin.ChunkProvider{info("A")},
+			edit:         func(e *wavebin.MetadataEditor) error { return e.Set(info(strings.Repeat("B", 64))) },
+			expectedInfo: info(strings.Repeat("B", 64)),
+			expectedGrow: chunkSize(info(strings.Repeat("B", 64))),
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.maxRIFFSize != 0 {
+				defer wavebin.SetMaxRIFFSize(tt.maxRIFFSize)()
+			}
+
+			f, err := os.CreateTemp("", "wavebin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			defer f.Close()
+
+			w, err := tt.create(f, format, tt.extras...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := w.Write(samples); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				t.Fatal(err)
+			}
+			stat, err := f.Stat()
+			if err != nil {
+				t.Fatal(err)
+			}
+			size := stat.Size()
+
+			editor, err := wavebin.NewMetadataEditor(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.edit(editor); err != nil {
+				t.Fatal(err)
+			}
+
+			stat, err = f.Stat()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if grow := stat.Size() - size; grow != tt.expectedGrow {
+				t.Errorf("unexpected file size growth: %d", grow)
+			}
+
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				t.Fatal(err)
+			}
+			decoder, err := wavebin.NewDecoder(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.expectedInfo, decoder.Info()); diff != "" {
+				t.Errorf("unexpected info: %s", diff)
+			}
+			if diff := cmp.Diff(tt.expectedBext, decoder.Chunks().Bext); diff != "" {
+				t.Errorf("unexpected bext: %s", diff)
+			}
+			got, err := io.ReadAll(decoder.Data())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(samples, got); diff != "" {
+				t.Errorf("unexpected samples: %s", diff)
+			}
+		})
+	}
+
+	t.Run("NotEditable", func(t *testing.T) {
+		f, err := os.CreateTemp("", "wavebin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		w, err := wavebin.CreateSampleWriter(f, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+
+		editor, err := wavebin.NewMetadataEditor(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := editor.Set(format); !errors.Is(err, wavebin.ErrUnexpectedChunkType) {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err := editor.Remove("data"); !errors.Is(err, wavebin.ErrUnexpectedChunkType) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("DataTooLarge", func(t *testing.T) {
+		f, err := os.CreateTemp("", "wavebin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		w, err := wavebin.CreateSampleWriter(f, format, info("A"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(samples); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		stat, err := f.Stat()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer wavebin.SetMaxRIFFSize(uint64(stat.Size()) - 8)()
+
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		editor, err := wavebin.NewMetadataEditor(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := editor.Set(info("B")); !errors.Is(err, wavebin.ErrDataTooLarge) {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		// the old chunk is kept
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		decoder, err := wavebin.NewDecoder(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(info("A"), decoder.Info()); diff != "" {
+			t.Errorf("unexpected info: %s", diff)
+		}
+	})
+
+	t.Run("OddSize", func(t *testing.T) {
+		f, err := os.CreateTemp("", "wavebin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		// 3 bytes of 8bit monoral samples are the odd-sized last chunk
+		format := &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		}
+		w, err := wavebin.CreateSampleWriter(f, format, info("A"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte{0x80, 0x81, 0x82}); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+
+		odd := &wavebin.RawChunk{ID: [4]byte{'o', 'd', 'd', ' '}, Payload: []byte{0x01, 0x02, 0x03}}
+		editor, err := wavebin.NewMetadataEditor(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := editor.Set(odd); err != nil {
+			t.Fatal(err)
+		}
+		if err := editor.Set(bext); err != nil {
+			t.Fatal(err)
+		}
+		if err := editor.Set(info("BBB")); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		riffChunk, err := wavebin.ReadWaveRIFF(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chunks, err := wavebin.ParseWaveChunks(riffChunk, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]wavebin.ChunkProvider{odd}, chunks.Unknown, readSectionChunk); diff != "" {
+			t.Errorf("unexpected unknown chunks: %s", diff)
+		}
+		if diff := cmp.Diff(bext, chunks.Bext); diff != "" {
+			t.Errorf("unexpected bext: %s", diff)
+		}
+		if diff := cmp.Diff(info("BBB"), chunks.Info); diff != "" {
+			t.Errorf("unexpected info: %s", diff)
+		}
+
+		// all chunks are word-aligned
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		findings, err := wavebin.ValidateReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(findings) != 0 {
+			t.Errorf("unexpected findings: %v", findings)
+		}
+	})
+}
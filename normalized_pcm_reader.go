@@ -0,0 +1,108 @@
+package wavebin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// NormalizedPCMReader reads sample frames as float64 values normalized to [-1, 1] for each channel.
+// The sample decoder is chosen automatically from the MetaFormat.
+type NormalizedPCMReader struct {
+	r              io.Reader
+	channels       int
+	bytesPerSample int
+	normalize      func(p []byte) float64
+	buf            []byte
+}
+
+// NewNormalizedPCMReader creates a NormalizedPCMReader for the samples of the format.
+// It returns ErrUnsupportedFormat if the format cannot be decoded.
+func NewNormalizedPCMReader(r io.Reader, format MetaFormat) (*NormalizedPCMReader, error) {
+	if format.Channels() == 0 {
+		return nil, fmt.Errorf("%w: no channels", ErrUnsupportedFormat)
+	}
+
+	bytesPerSample := int(format.BlockAlign() / format.Channels())
+	normalize, err := chooseSampleNormalizer(CompressionCode(EffectiveCompressionCode(format)), bytesPerSample)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NormalizedPCMReader{
+		r:              r,
+		channels:       int(format.Channels()),
+		bytesPerSample: bytesPerSample,
+		normalize:      normalize,
+		buf:            make([]byte, int(format.Channels())*bytesPerSample),
+	}, nil
+}
+
+// Channels returns the number of values in a frame returned by ReadSample.
+func (r *NormalizedPCMReader) Channels() int {
+	return r.channels
+}
+
+// ReadSample reads a sample frame. It returns io.EOF if there are no more frames.
+func (r *NormalizedPCMReader) ReadSample() ([]float64, error) {
+	_, err := io.ReadFull(r.r, r.buf)
+	if err != nil {
+		return nil, err
+	}
+
+	frame := make([]float64, r.channels)
+	for i := range frame {
+		frame[i] = r.normalize(r.buf[i*r.bytesPerSample : (i+1)*r.bytesPerSample])
+	}
+	return frame, nil
+}
+
+func chooseSampleNormalizer(code CompressionCode, bytesPerSample int) (func(p []byte) float64, error) {
+	switch code {
+	case pcmCompressionCode:
+		switch bytesPerSample {
+		case 1:
+			return normalizePCM8bit, nil
+		case 2:
+			return normalizePCM16bit, nil
+		case 3:
+			return normalizePCM24bit, nil
+		case 4:
+			return normalizePCM32bit, nil
+		}
+	case ieeeFloatCompressionCode:
+		switch bytesPerSample {
+		case 4:
+			return normalizeIEEEFloat32bit, nil
+		case 8:
+			return normalizeIEEEFloat64bit, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: compression code 0x%04X with %d bytes per sample", ErrUnsupportedFormat, uint16(code), bytesPerSample)
+}
+
+func normalizePCM8bit(p []byte) float64 {
+	return (float64(p[0]) - 128) / 128
+}
+
+func normalizePCM16bit(p []byte) float64 {
+	return float64(decode16bitSignedInt(binary.LittleEndian.Uint16(p))) / (1 << 15)
+}
+
+func normalizePCM24bit(p []byte) float64 {
+	return float64(decode24bitSignedInt(uint24(p))) / (1 << 23)
+}
+
+func normalizePCM32bit(p []byte) float64 {
+	return float64(decode32bitSignedInt(binary.LittleEndian.Uint32(p))) / (1 << 31)
+}
+
+func normalizeIEEEFloat32bit(p []byte) float64 {
+	return float64(math.Float32frombits(binary.LittleEndian.Uint32(p)))
+}
+
+func normalizeIEEEFloat64bit(p []byte) float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(p))
+}
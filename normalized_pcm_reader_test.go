@@ -0,0 +1,102 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestNormalizedPCMReader(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		format   wavebin.MetaFormat
+		rawBytes []byte
+		expected [][]float64
+	}{
+		{
+			name:     "8BitMonoral",
+			format:   wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+			rawBytes: []byte{0x00, 0x80, 0xC0},
+			expected: [][]float64{{-1}, {0}, {0.5}},
+		},
+		{
+			name:     "16BitStereo",
+			format:   wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+			rawBytes: []byte{0x00, 0x80, 0x00, 0x40},
+			expected: [][]float64{{-1, 0.5}},
+		},
+		{
+			name:     "24BitMonoral",
+			format:   wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 48000, 24),
+			rawBytes: []byte{0x00, 0x00, 0x80, 0x00, 0x00, 0xC0},
+			expected: [][]float64{{-1}, {-0.5}},
+		},
+		{
+			name:     "32BitQuadraphonic",
+			format:   wavebin.NewPCMMetaFormat(wavebin.QuadraphonicChannels, 48000, 32),
+			rawBytes: []byte{0x00, 0x00, 0x00, 0x80, 0x00, 0x00, 0x00, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xC0},
+			expected: [][]float64{{-1, 0.5, 0, -0.5}},
+		},
+		{
+			name:     "32BitFloatStereo",
+			format:   wavebin.NewIEEEFloatMetaFormat(wavebin.StereoChannels, 48000, 32),
+			rawBytes: []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0xbf},
+			expected: [][]float64{{1, -0.5}},
+		},
+		{
+			name:     "Extensible24BitStereo",
+			format:   wavebin.NewExtensibleMetaFormat(wavebin.StereoChannels, 48000, 24, 24, 0x3, wavebin.SubFormatPCM),
+			rawBytes: []byte{0x00, 0x00, 0x80, 0x00, 0x00, 0x40},
+			expected: [][]float64{{-1, 0.5}},
+		},
+		{
+			name:     "Extensible32BitFloatMonoral",
+			format:   wavebin.NewExtensibleMetaFormat(wavebin.MonoralChannels, 48000, 32, 32, 0x4, wavebin.SubFormatIEEEFloat),
+			rawBytes: []byte{0x00, 0x00, 0x80, 0x3f},
+			expected: [][]float64{{1}},
+		},
+		{
+			name:     "64BitFloatMonoral",
+			format:   wavebin.NewIEEEFloatMetaFormat(wavebin.MonoralChannels, 48000, 64),
+			rawBytes: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0xbf},
+			expected: [][]float64{{-0.5}},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := wavebin.NewNormalizedPCMReader(bytes.NewReader(tt.rawBytes), tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.Channels() != int(tt.format.Channels()) {
+				t.Errorf("Channels should be %d but got: %d", tt.format.Channels(), r.Channels())
+			}
+
+			var frames [][]float64
+			for {
+				frame, err := r.ReadSample()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				frames = append(frames, frame)
+			}
+			if df := cmp.Diff(tt.expected, frames); df != "" {
+				t.Error(df)
+			}
+		})
+	}
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		_, err := wavebin.NewNormalizedPCMReader(bytes.NewReader(nil), wavebin.NewIEEEFloatMetaFormat(wavebin.MonoralChannels, 48000, 16))
+		if !errors.Is(err, wavebin.ErrUnsupportedFormat) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package wavebin
+
+import (
+	"fmt"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// ChunkDecoder decodes a chunk into a ChunkProvider to be written again.
+type ChunkDecoder func(chunk riffbin.Chunk) (ChunkProvider, error)
+
+// ParseOptions is the options for ParseWaveChunksWithOptions.
+// The zero value is the strict options.
+type ParseOptions struct {
+	// IgnoreUnknownChunk keeps the unknown chunks in WaveChunks.Unknown instead of returning ErrUnknownChunk or ErrUnknownListType.
+	IgnoreUnknownChunk bool
+
+	// AllowDuplicateChunk uses the last one of the duplicate fmt or data chunks instead of returning ErrDuplicateChunk.
+	AllowDuplicateChunk bool
+
+	// KeepJunkChunk keeps JUNK and junk chunks in WaveChunks.Extras instead of skipping them.
+	KeepJunkChunk bool
+
+	// AllowMissingFact allows the non-PCM format without the fact chunk.
+	AllowMissingFact bool
+
+	// ChunkDecoders is the decoders for the sub-chunks by the chunk ID.
+	// They take precedence over the built-in decoders except fmt and data chunks, and the results are in WaveChunks.Custom.
+	ChunkDecoders map[[4]byte]ChunkDecoder
+
+	// ListDecoders is the decoders for the LIST chunks by the list type as well as ChunkDecoders.
+	ListDecoders map[[4]byte]ChunkDecoder
+
+	// InfoTextDecoder decodes the texts in the INFO list chunk.
+	// If it's nil, the texts are decoded by the character set declared in the CSET chunk, or kept as is.
+	InfoTextDecoder TextDecoder
+
+	// Registry is the registry of the decoders for the chunks which are not built-in, and the results are in WaveChunks.Custom.
+	// DefaultChunkRegistry is used if it's nil.
+	Registry *ChunkRegistry
+}
+
+func (o *ParseOptions) lookupDecoder(chunk riffbin.Chunk) (ChunkDecoder, bool) {
+	var id [4]byte
+	copy(id[:], chunk.ChunkID())
+	if id == fmtBytes || id == dataBytes {
+		return nil, false
+	}
+
+	if listChunk, ok := chunk.(*riffbin.ListChunk); ok {
+		if decoder, ok := o.ListDecoders[listChunk.ListType]; ok {
+			return decoder, true
+		}
+	} else if decoder, ok := o.ChunkDecoders[id]; ok {
+		return decoder, true
+	}
+	return nil, false
+}
+
+// lookupRegistry looks up the decoder for the chunk which is not built-in from Registry or DefaultChunkRegistry.
+func (o *ParseOptions) lookupRegistry(chunk riffbin.Chunk) (ChunkDecoder, bool) {
+	registry := o.Registry
+	if registry == nil {
+		registry = DefaultChunkRegistry
+	}
+	return registry.lookup(chunk)
+}
+
+// chunkName returns the name of the chunk for the error messages.
+func chunkName(chunk riffbin.Chunk) string {
+	if listChunk, ok := chunk.(*riffbin.ListChunk); ok {
+		return fmt.Sprintf("LIST[%s]", string(listChunk.ListType[:]))
+	}
+	return string(chunk.ChunkID())
+}
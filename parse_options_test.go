@@ -0,0 +1,157 @@
+package wavebin_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+type vendorChunk struct {
+	Value string
+}
+
+func (c *vendorChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{ID: [4]byte{'v', 'n', 'd', 'r'}, Payload: []byte(c.Value)}
+}
+
+func decodeVendorChunk(chunk riffbin.Chunk) (wavebin.ChunkProvider, error) {
+	b, err := io.ReadAll(chunk.(riffbin.SubChunk))
+	if err != nil {
+		return nil, err
+	}
+	return &vendorChunk{Value: string(b)}, nil
+}
+
+func TestParseWaveChunksWithOptions(t *testing.T) {
+	pcmFormat := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+	}
+	floatFormat := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewIEEEFloatMetaFormat(wavebin.MonoralChannels, 44100, 32),
+	}
+	dataChunk := &riffbin.OnMemorySubChunk{ID: [4]byte{'d', 'a', 't', 'a'}, Payload: []byte{0x00, 0x00}}
+	junkChunk := &riffbin.OnMemorySubChunk{ID: [4]byte{'J', 'U', 'N', 'K'}, Payload: []byte{0x00, 0x00, 0x00, 0x00}}
+	newVendorSubChunk := func() riffbin.Chunk {
+		return &riffbin.OnMemorySubChunk{ID: [4]byte{'v', 'n', 'd', 'r'}, Payload: []byte("vendor")}
+	}
+
+	for _, tt := range []struct {
+		name           string
+		payload        []riffbin.Chunk
+		opts           wavebin.ParseOptions
+		expectedError  error
+		expectedExtra  []wavebin.ChunkProvider
+		expectedCustom []wavebin.ChunkProvider
+	}{
+		{
+			name:          "DuplicateFormatStrict",
+			payload:       []riffbin.Chunk{pcmFormat.Chunk(), pcmFormat.Chunk(), dataChunk},
+			expectedError: wavebin.ErrDuplicateChunk,
+		},
+		{
+			name:    "DuplicateFormatLenient",
+			payload: []riffbin.Chunk{pcmFormat.Chunk(), pcmFormat.Chunk(), dataChunk},
+			opts:    wavebin.ParseOptions{AllowDuplicateChunk: true},
+		},
+		{
+			name:          "DuplicateDataStrict",
+			payload:       []riffbin.Chunk{pcmFormat.Chunk(), dataChunk, dataChunk},
+			expectedError: wavebin.ErrDuplicateChunk,
+		},
+		{
+			name:    "SkipJunk",
+			payload: []riffbin.Chunk{pcmFormat.Chunk(), junkChunk, dataChunk},
+		},
+		{
+			name:    "KeepJunk",
+			payload: []riffbin.Chunk{pcmFormat.Chunk(), junkChunk, dataChunk},
+			opts:    wavebin.ParseOptions{KeepJunkChunk: true},
+			expectedExtra: []wavebin.ChunkProvider{
+				&wavebin.RawChunk{ID: [4]byte{'J', 'U', 'N', 'K'}, Payload: []byte{0x00, 0x00, 0x00, 0x00}},
+			},
+		},
+		{
+			name:          "MissingFactStrict",
+			payload:       []riffbin.Chunk{floatFormat.Chunk(), dataChunk},
+			expectedError: wavebin.ErrLackOfRequiredChunks,
+		},
+		{
+			name:    "MissingFactLenient",
+			payload: []riffbin.Chunk{floatFormat.Chunk(), dataChunk},
+			opts:    wavebin.ParseOptions{AllowMissingFact: true},
+		},
+		{
+			name:          "UnknownChunk",
+			payload:       []riffbin.Chunk{pcmFormat.Chunk(), newVendorSubChunk(), dataChunk},
+			expectedError: wavebin.ErrUnknownChunk,
+		},
+		{
+			name:    "ChunkDecoder",
+			payload: []riffbin.Chunk{pcmFormat.Chunk(), newVendorSubChunk(), dataChunk},
+			opts: wavebin.ParseOptions{
+				ChunkDecoders: map[[4]byte]wavebin.ChunkDecoder{
+					{'v', 'n', 'd', 'r'}: decodeVendorChunk,
+				},
+			},
+			expectedExtra:  []wavebin.ChunkProvider{&vendorChunk{Value: "vendor"}},
+			expectedCustom: []wavebin.ChunkProvider{&vendorChunk{Value: "vendor"}},
+		},
+		{
+			name: "ListDecoder",
+			payload: []riffbin.Chunk{
+				pcmFormat.Chunk(),
+				&riffbin.ListChunk{ListType: [4]byte{'v', 'n', 'd', 'r'}, Payload: []riffbin.Chunk{newVendorSubChunk()}},
+				dataChunk,
+			},
+			opts: wavebin.ParseOptions{
+				ListDecoders: map[[4]byte]wavebin.ChunkDecoder{
+					{'v', 'n', 'd', 'r'}: func(chunk riffbin.Chunk) (wavebin.ChunkProvider, error) {
+						return decodeVendorChunk(chunk.(*riffbin.ListChunk).Payload[0])
+					},
+				},
+			},
+			expectedExtra:  []wavebin.ChunkProvider{&vendorChunk{Value: "vendor"}},
+			expectedCustom: []wavebin.ChunkProvider{&vendorChunk{Value: "vendor"}},
+		},
+		{
+			name:    "ChunkDecoderError",
+			payload: []riffbin.Chunk{pcmFormat.Chunk(), newVendorSubChunk(), dataChunk},
+			opts: wavebin.ParseOptions{
+				ChunkDecoders: map[[4]byte]wavebin.ChunkDecoder{
+					{'v', 'n', 'd', 'r'}: func(riffbin.Chunk) (wavebin.ChunkProvider, error) {
+						return nil, wavebin.ErrUnexpectedChunkSize
+					},
+				},
+			},
+			expectedError: wavebin.ErrUnexpectedChunkSize,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			chunks, err := wavebin.ParseWaveChunksWithOptions(&riffbin.RIFFChunk{
+				FormType: [4]byte{'W', 'A', 'V', 'E'},
+				Payload:  tt.payload,
+			}, tt.opts)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("unexpected err: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if df := cmp.Diff(tt.expectedExtra, chunks.Extras); df != "" {
+				t.Errorf("extras diff: %s", df)
+			}
+			if df := cmp.Diff(tt.expectedCustom, chunks.Custom); df != "" {
+				t.Errorf("custom diff: %s", df)
+			}
+		})
+	}
+}
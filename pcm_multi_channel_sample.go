@@ -0,0 +1,155 @@
+package wavebin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// PCMMultiChannelSample is a sample frame with an arbitrary number of channels.
+// Samples holds a value per channel in the order of the channel mask.
+// 8bit values are unsigned (0-255) as well as PCM8BitMonoralSample, the others are signed integers.
+type PCMMultiChannelSample struct {
+	BytesPerSample int
+	Samples        []int32
+}
+
+// NewPCMMultiChannelSample creates a zero-filled sample frame for the format.
+// It returns ErrUnsupportedFormat as well as NewPCMMultiChannelSampleParser.
+func NewPCMMultiChannelSample(format MetaFormat) (PCMMultiChannelSample, error) {
+	p, err := NewPCMMultiChannelSampleParser(format)
+	if err != nil {
+		return PCMMultiChannelSample{}, err
+	}
+
+	return PCMMultiChannelSample{
+		BytesPerSample: p.BytesPerSample,
+		Samples:        make([]int32, p.Channels),
+	}, nil
+}
+
+// PutSamples puts the samples to p. It puts nothing if the sample has no channels or BytesPerSample is not in 1-4.
+// PCMWriter returns ErrUnsupportedFormat for such samples instead of writing them.
+func (s PCMMultiChannelSample) PutSamples(p []byte) {
+	if s.validate() != nil {
+		return
+	}
+
+	_ = p[s.ByteSize()-1] // early bounds check to guarantee safety of writes below
+	for i, v := range s.Samples {
+		if err := putPCMSignedInt(p[i*s.BytesPerSample:(i+1)*s.BytesPerSample], v); err != nil {
+			return
+		}
+	}
+}
+
+func (s PCMMultiChannelSample) ByteSize() int {
+	return s.BytesPerSample * len(s.Samples)
+}
+
+func (s PCMMultiChannelSample) validate() error {
+	if len(s.Samples) == 0 {
+		return fmt.Errorf("%w: no channels", ErrUnsupportedFormat)
+	}
+	if s.BytesPerSample < 1 || 4 < s.BytesPerSample {
+		return fmt.Errorf("%w: %d bytes per sample", ErrUnsupportedFormat, s.BytesPerSample)
+	}
+	return nil
+}
+
+type PCMMultiChannelSampleParser struct {
+	Channels       int
+	BytesPerSample int
+}
+
+var (
+	_ PCMSampleParser[PCMMultiChannelSample]      = PCMMultiChannelSampleParser{}
+	_ PCMSampleBytesParser[PCMMultiChannelSample] = PCMMultiChannelSampleParser{}
+)
+
+// NewPCMMultiChannelSampleParser creates a parser for the integer PCM format.
+// It returns ErrUnsupportedFormat if the format is not an integer PCM or its sample size is not in 8-32 bits.
+func NewPCMMultiChannelSampleParser(format MetaFormat) (PCMMultiChannelSampleParser, error) {
+	if code := EffectiveCompressionCode(format); CompressionCode(code) != pcmCompressionCode {
+		return PCMMultiChannelSampleParser{}, fmt.Errorf("%w: compression code 0x%04X", ErrUnsupportedFormat, code)
+	}
+	if format.Channels() == 0 {
+		return PCMMultiChannelSampleParser{}, fmt.Errorf("%w: no channels", ErrUnsupportedFormat)
+	}
+
+	bytesPerSample := int(format.BlockAlign() / format.Channels())
+	if bytesPerSample < 1 || 4 < bytesPerSample {
+		return PCMMultiChannelSampleParser{}, fmt.Errorf("%w: %d bytes per sample", ErrUnsupportedFormat, bytesPerSample)
+	}
+
+	return PCMMultiChannelSampleParser{
+		Channels:       int(format.Channels()),
+		BytesPerSample: bytesPerSample,
+	}, nil
+}
+
+func (p PCMMultiChannelSampleParser) ParseFromReader(r io.Reader) (PCMMultiChannelSample, error) {
+	b := make([]byte, p.ByteSize())
+	_, err := io.ReadFull(r, b)
+	if err != nil {
+		return PCMMultiChannelSample{}, err
+	}
+
+	return p.parseFromBytes(b)
+}
+
+func (p PCMMultiChannelSampleParser) ByteSize() int {
+	return p.Channels * p.BytesPerSample
+}
+
+// ParseFromBytes parses the samples from b. The samples are zero if BytesPerSample is not in 1-4.
+func (p PCMMultiChannelSampleParser) ParseFromBytes(b []byte) PCMMultiChannelSample {
+	s, _ := p.parseFromBytes(b)
+	return s
+}
+
+func (p PCMMultiChannelSampleParser) parseFromBytes(b []byte) (PCMMultiChannelSample, error) {
+	s := PCMMultiChannelSample{
+		BytesPerSample: p.BytesPerSample,
+		Samples:        make([]int32, p.Channels),
+	}
+	for i := range s.Samples {
+		v, err := pcmSignedInt(b[i*p.BytesPerSample : (i+1)*p.BytesPerSample])
+		if err != nil {
+			return PCMMultiChannelSample{BytesPerSample: p.BytesPerSample, Samples: make([]int32, p.Channels)}, err
+		}
+		s.Samples[i] = v
+	}
+	return s, nil
+}
+
+func putPCMSignedInt(p []byte, v int32) error {
+	switch len(p) {
+	case 1:
+		p[0] = uint8(v)
+	case 2:
+		binary.LittleEndian.PutUint16(p, encode16bitSignedInt(int16(v)))
+	case 3:
+		putUint24(p, encode24bitSignedInt(v))
+	case 4:
+		binary.LittleEndian.PutUint32(p, encode32bitSignedInt(v))
+	default:
+		return fmt.Errorf("%w: %d bytes per sample", ErrUnsupportedFormat, len(p))
+	}
+	return nil
+}
+
+func pcmSignedInt(p []byte) (int32, error) {
+	switch len(p) {
+	case 1:
+		return int32(p[0]), nil
+	case 2:
+		return int32(decode16bitSignedInt(binary.LittleEndian.Uint16(p))), nil
+	case 3:
+		return decode24bitSignedInt(uint24(p)), nil
+	case 4:
+		return decode32bitSignedInt(binary.LittleEndian.Uint32(p)), nil
+	default:
+		return 0, fmt.Errorf("%w: %d bytes per sample", ErrUnsupportedFormat, len(p))
+	}
+}
@@ -0,0 +1,185 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestPCMMultiChannelSample(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		format   wavebin.MetaFormat
+		rawBytes []byte
+		expected []wavebin.PCMMultiChannelSample
+	}{
+		{
+			name:     "8BitQuadraphonic",
+			format:   wavebin.NewPCMMetaFormat(wavebin.QuadraphonicChannels, 44100, 8),
+			rawBytes: []byte{0x00, 0x7F, 0x80, 0xFF},
+			expected: []wavebin.PCMMultiChannelSample{
+				{BytesPerSample: 1, Samples: []int32{0, 127, 128, 255}},
+			},
+		},
+		{
+			name:   "16BitSurround",
+			format: wavebin.NewPCMMetaFormat(wavebin.SurroundChannels, 48000, 16),
+			rawBytes: []byte{
+				0xff, 0xff, 0x01, 0x00, 0x02, 0x00, 0xfe, 0xff, 0x00, 0x80, 0xff, 0x7f,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00,
+			},
+			expected: []wavebin.PCMMultiChannelSample{
+				{BytesPerSample: 2, Samples: []int32{-1, 1, 2, -2, -32768, 32767}},
+				{BytesPerSample: 2, Samples: []int32{0, 0, 0, 0, 0, 1}},
+			},
+		},
+		{
+			name:   "24Bit7.1ch",
+			format: wavebin.NewExtensibleMetaFormat(8, 48000, 24, 24, 0x63F, wavebin.SubFormatPCM),
+			rawBytes: []byte{
+				0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x00, 0x00, 0x80,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x56, 0x34, 0x12,
+			},
+			expected: []wavebin.PCMMultiChannelSample{
+				{BytesPerSample: 3, Samples: []int32{-1, 1, 8388607, -8388608, 0, 0, 0, 0x123456}},
+			},
+		},
+		{
+			name:   "32BitStereo",
+			format: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 48000, 32),
+			rawBytes: []byte{
+				0xff, 0xff, 0xff, 0xff, 0x78, 0x56, 0x34, 0x12,
+			},
+			expected: []wavebin.PCMMultiChannelSample{
+				{BytesPerSample: 4, Samples: []int32{-1, 0x12345678}},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := wavebin.NewPCMMultiChannelSampleParser(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := wavebin.NewPCMReader[wavebin.PCMMultiChannelSample](bytes.NewReader(tt.rawBytes), p)
+			var samples []wavebin.PCMMultiChannelSample
+			for {
+				sample, err := r.ReadSample()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				samples = append(samples, sample)
+			}
+			if df := cmp.Diff(tt.expected, samples); df != "" {
+				t.Errorf("read samples diff: %s", df)
+			}
+
+			var buf bytes.Buffer
+			w := &wavebin.PCMWriter[wavebin.PCMMultiChannelSample]{W: &buf}
+			_, err = w.WriteSamples(tt.expected...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if df := cmp.Diff(tt.rawBytes, buf.Bytes()); df != "" {
+				t.Errorf("written bytes diff: %s", df)
+			}
+		})
+	}
+
+	t.Run("NewPCMMultiChannelSample", func(t *testing.T) {
+		s, err := wavebin.NewPCMMultiChannelSample(wavebin.NewPCMMetaFormat(wavebin.SurroundChannels, 48000, 24))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.ByteSize() != 18 {
+			t.Errorf("ByteSize should be 18 but got: %d", s.ByteSize())
+		}
+
+		_, err = wavebin.NewPCMMultiChannelSample(wavebin.NewPCMMetaFormat(0, 48000, 16))
+		if !errors.Is(err, wavebin.ErrUnsupportedFormat) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+
+	t.Run("UnsupportedBytesPerSample", func(t *testing.T) {
+		p := wavebin.PCMMultiChannelSampleParser{Channels: 2, BytesPerSample: 5}
+		_, err := p.ParseFromReader(bytes.NewReader(make([]byte, 10)))
+		if !errors.Is(err, wavebin.ErrUnsupportedFormat) {
+			t.Errorf("unexpected err: %v", err)
+		}
+
+		b := make([]byte, 10)
+		wavebin.PCMMultiChannelSample{BytesPerSample: 5, Samples: []int32{1, 2}}.PutSamples(b)
+		if !bytes.Equal(b, make([]byte, 10)) {
+			t.Errorf("unexpected bytes: %v", b)
+		}
+	})
+
+	t.Run("InvalidSampleWrite", func(t *testing.T) {
+		for _, tt := range []struct {
+			name   string
+			sample wavebin.PCMMultiChannelSample
+		}{
+			{"ZeroValue", wavebin.PCMMultiChannelSample{}},
+			{"NoChannels", wavebin.PCMMultiChannelSample{BytesPerSample: 2}},
+			{"ZeroBytesPerSample", wavebin.PCMMultiChannelSample{Samples: []int32{1, 2}}},
+			{"UnsupportedBytesPerSample", wavebin.PCMMultiChannelSample{BytesPerSample: 5, Samples: []int32{1, 2}}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.PCMMultiChannelSample]{W: &buf}
+
+				// fill the reused buffer with the valid sample at first
+				valid := wavebin.PCMMultiChannelSample{BytesPerSample: 2, Samples: []int32{1, 2}}
+				if _, err := w.WriteSamples(valid); err != nil {
+					t.Fatal(err)
+				}
+				buf.Reset()
+
+				n, err := w.WriteSamples(valid, tt.sample)
+				if !errors.Is(err, wavebin.ErrUnsupportedFormat) {
+					t.Errorf("unexpected err: %v", err)
+				}
+				if n != 0 || buf.Len() != 0 {
+					t.Errorf("should write nothing but got %d bytes: %v", n, buf.Bytes())
+				}
+			})
+		}
+	})
+
+	t.Run("InvalidBytes", func(t *testing.T) {
+		p, err := wavebin.NewPCMMultiChannelSampleParser(wavebin.NewPCMMetaFormat(wavebin.SurroundChannels, 48000, 16))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r := wavebin.NewPCMReader[wavebin.PCMMultiChannelSample](bytes.NewReader(make([]byte, 11)), p)
+		_, err = r.ReadSample()
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		for _, format := range []wavebin.MetaFormat{
+			wavebin.NewIEEEFloatMetaFormat(wavebin.StereoChannels, 48000, 32),
+			wavebin.NewExtensibleMetaFormat(wavebin.StereoChannels, 48000, 32, 32, 0x3, wavebin.SubFormatIEEEFloat),
+			wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 48000, 64),
+			wavebin.NewPCMMetaFormat(0, 48000, 16),
+		} {
+			_, err := wavebin.NewPCMMultiChannelSampleParser(format)
+			if !errors.Is(err, wavebin.ErrUnsupportedFormat) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		}
+	})
+}
@@ -1,10 +1,17 @@
 package wavebin
 
-import "io"
+import (
+	"errors"
+	"io"
+)
+
+// pcmBufferSize is the max size of the buffer to read or write many samples at once.
+const pcmBufferSize = 32 * 1024
 
 type PCMReader[T PCMSample] struct {
-	r io.Reader
-	p PCMSampleParser[T]
+	r   io.Reader
+	p   PCMSampleParser[T]
+	buf []byte
 }
 
 func NewPCMReader[T PCMSample](r io.Reader, p PCMSampleParser[T]) *PCMReader[T] {
@@ -14,3 +21,58 @@ func NewPCMReader[T PCMSample](r io.Reader, p PCMSampleParser[T]) *PCMReader[T]
 func (r *PCMReader[T]) ReadSample() (T, error) {
 	return r.p.ParseFromReader(r.r)
 }
+
+// ReadSamples reads up to len(dst) samples into dst, and returns the number of the samples read.
+// It returns io.EOF only if no samples are read, and io.ErrUnexpectedEOF if the last sample is incomplete.
+// If the parser implements PCMSampleBytesParser, the samples are read at once and parsed from the bytes.
+func (r *PCMReader[T]) ReadSamples(dst []T) (int, error) {
+	bp, ok := r.p.(PCMSampleBytesParser[T])
+	if !ok {
+		for i := range dst {
+			sample, err := r.p.ParseFromReader(r.r)
+			if errors.Is(err, io.EOF) && i != 0 {
+				return i, nil
+			} else if err != nil {
+				return i, err
+			}
+
+			dst[i] = sample
+		}
+		return len(dst), nil
+	}
+
+	size := bp.ByteSize()
+	if size <= 0 {
+		return 0, ErrUnexpectedBlockAlign
+	}
+	if r.buf == nil {
+		bufSize := pcmBufferSize - pcmBufferSize%size
+		if bufSize == 0 {
+			bufSize = size
+		}
+		r.buf = make([]byte, bufSize)
+	}
+
+	n := 0
+	for n < len(dst) {
+		bufSize := (len(dst) - n) * size
+		if bufSize > len(r.buf) {
+			bufSize = len(r.buf)
+		}
+
+		nn, err := io.ReadFull(r.r, r.buf[:bufSize])
+		for off := 0; off+size <= nn; off += size {
+			dst[n] = bp.ParseFromBytes(r.buf[off : off+size])
+			n++
+		}
+		if errors.Is(err, io.EOF) && n != 0 {
+			return n, nil
+		} else if errors.Is(err, io.ErrUnexpectedEOF) && nn%size == 0 {
+			return n, nil
+		} else if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
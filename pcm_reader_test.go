@@ -172,4 +172,430 @@ func TestPCMReader(t *testing.T) {
 			}
 		})
 	})
+	t.Run("24BitMonoral", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			rawBytes []byte
+			expected []wavebin.PCM24BitMonoralSample
+		}{
+			{"Empty", []byte{}, nil},
+			{"24BitMonoral1Sample", []byte{0x00, 0x00, 0x00}, []wavebin.PCM24BitMonoralSample{0}},
+			{"24BitMonoral2Samples", []byte{0xff, 0xff, 0xff, 0x01, 0x00, 0x00}, []wavebin.PCM24BitMonoralSample{-1, 1}},
+			{"24BitMonoral3Samples", []byte{0xff, 0xff, 0x7f, 0x00, 0x00, 0x80, 0x56, 0x34, 0x12}, []wavebin.PCM24BitMonoralSample{8388607, -8388608, 0x123456}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				r := wavebin.NewPCMReader[wavebin.PCM24BitMonoralSample](bytes.NewReader(tt.rawBytes), wavebin.PCM24BitMonoralSampleParser{})
+
+				var samples []wavebin.PCM24BitMonoralSample
+				for {
+					sample, err := r.ReadSample()
+					if errors.Is(err, io.EOF) {
+						break
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					samples = append(samples, sample)
+				}
+
+				if df := cmp.Diff(tt.expected, samples); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+
+		t.Run("InvalidBytes", func(t *testing.T) {
+			r := wavebin.NewPCMReader[wavebin.PCM24BitMonoralSample](bytes.NewReader([]byte{0x00, 0x00}), wavebin.PCM24BitMonoralSampleParser{})
+
+			_, err := r.ReadSample()
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	})
+	t.Run("24BitStereo", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			rawBytes []byte
+			expected []wavebin.PCM24BitStereoSample
+		}{
+			{"Empty", []byte{}, nil},
+			{"24BitStereo1Sample", []byte{0xff, 0xff, 0xff, 0x01, 0x00, 0x00}, []wavebin.PCM24BitStereoSample{{L: -1, R: 1}}},
+			{"24BitStereo2Sample", []byte{0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x02, 0x00, 0x00, 0xfe, 0xff, 0xff}, []wavebin.PCM24BitStereoSample{{L: -1, R: 1}, {L: 2, R: -2}}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				r := wavebin.NewPCMReader[wavebin.PCM24BitStereoSample](bytes.NewReader(tt.rawBytes), wavebin.PCM24BitStereoSampleParser{})
+
+				var samples []wavebin.PCM24BitStereoSample
+				for {
+					sample, err := r.ReadSample()
+					if errors.Is(err, io.EOF) {
+						break
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					samples = append(samples, sample)
+				}
+
+				if df := cmp.Diff(tt.expected, samples); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+
+		t.Run("InvalidBytes", func(t *testing.T) {
+			r := wavebin.NewPCMReader[wavebin.PCM24BitStereoSample](bytes.NewReader([]byte{0x00, 0x00, 0x00, 0x00, 0x00}), wavebin.PCM24BitStereoSampleParser{})
+
+			_, err := r.ReadSample()
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	})
+	t.Run("32BitMonoral", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			rawBytes []byte
+			expected []wavebin.PCM32BitMonoralSample
+		}{
+			{"Empty", []byte{}, nil},
+			{"32BitMonoral1Sample", []byte{0x00, 0x00, 0x00, 0x00}, []wavebin.PCM32BitMonoralSample{0}},
+			{"32BitMonoral2Samples", []byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x00}, []wavebin.PCM32BitMonoralSample{-1, 1}},
+			{"32BitMonoral3Samples", []byte{0xff, 0xff, 0xff, 0x7f, 0x00, 0x00, 0x00, 0x80, 0x78, 0x56, 0x34, 0x12}, []wavebin.PCM32BitMonoralSample{2147483647, -2147483648, 0x12345678}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				r := wavebin.NewPCMReader[wavebin.PCM32BitMonoralSample](bytes.NewReader(tt.rawBytes), wavebin.PCM32BitMonoralSampleParser{})
+
+				var samples []wavebin.PCM32BitMonoralSample
+				for {
+					sample, err := r.ReadSample()
+					if errors.Is(err, io.EOF) {
+						break
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					samples = append(samples, sample)
+				}
+
+				if df := cmp.Diff(tt.expected, samples); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+
+		t.Run("InvalidBytes", func(t *testing.T) {
+			r := wavebin.NewPCMReader[wavebin.PCM32BitMonoralSample](bytes.NewReader([]byte{0x00, 0x00, 0x00}), wavebin.PCM32BitMonoralSampleParser{})
+
+			_, err := r.ReadSample()
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	})
+	t.Run("32BitStereo", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			rawBytes []byte
+			expected []wavebin.PCM32BitStereoSample
+		}{
+			{"Empty", []byte{}, nil},
+			{"32BitStereo1Sample", []byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x00}, []wavebin.PCM32BitStereoSample{{L: -1, R: 1}}},
+			{"32BitStereo2Sample", []byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0xfe, 0xff, 0xff, 0xff}, []wavebin.PCM32BitStereoSample{{L: -1, R: 1}, {L: 2, R: -2}}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				r := wavebin.NewPCMReader[wavebin.PCM32BitStereoSample](bytes.NewReader(tt.rawBytes), wavebin.PCM32BitStereoSampleParser{})
+
+				var samples []wavebin.PCM32BitStereoSample
+				for {
+					sample, err := r.ReadSample()
+					if errors.Is(err, io.EOF) {
+						break
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					samples = append(samples, sample)
+				}
+
+				if df := cmp.Diff(tt.expected, samples); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+
+		t.Run("InvalidBytes", func(t *testing.T) {
+			r := wavebin.NewPCMReader[wavebin.PCM32BitStereoSample](bytes.NewReader([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}), wavebin.PCM32BitStereoSampleParser{})
+
+			_, err := r.ReadSample()
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	})
+	t.Run("32BitFloatMonoral", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			rawBytes []byte
+			expected []wavebin.IEEEFloat32BitMonoralSample
+		}{
+			{"Empty", []byte{}, nil},
+			{"32BitFloatMonoral1Sample", []byte{0x00, 0x00, 0x00, 0x00}, []wavebin.IEEEFloat32BitMonoralSample{0}},
+			{"32BitFloatMonoral2Samples", []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0xbf}, []wavebin.IEEEFloat32BitMonoralSample{1, -0.5}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				r := wavebin.NewPCMReader[wavebin.IEEEFloat32BitMonoralSample](bytes.NewReader(tt.rawBytes), wavebin.IEEEFloat32BitMonoralSampleParser{})
+
+				var samples []wavebin.IEEEFloat32BitMonoralSample
+				for {
+					sample, err := r.ReadSample()
+					if errors.Is(err, io.EOF) {
+						break
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					samples = append(samples, sample)
+				}
+
+				if df := cmp.Diff(tt.expected, samples); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+
+		t.Run("InvalidBytes", func(t *testing.T) {
+			r := wavebin.NewPCMReader[wavebin.IEEEFloat32BitMonoralSample](bytes.NewReader([]byte{0x00, 0x00, 0x00}), wavebin.IEEEFloat32BitMonoralSampleParser{})
+
+			_, err := r.ReadSample()
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	})
+	t.Run("32BitFloatStereo", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			rawBytes []byte
+			expected []wavebin.IEEEFloat32BitStereoSample
+		}{
+			{"Empty", []byte{}, nil},
+			{"32BitFloatStereo1Sample", []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0xbf}, []wavebin.IEEEFloat32BitStereoSample{{L: 1, R: -0.5}}},
+			{"32BitFloatStereo2Sample", []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0xbf, 0x00, 0x00, 0x80, 0xbf, 0x00, 0x00, 0x00, 0x00}, []wavebin.IEEEFloat32BitStereoSample{{L: 1, R: -0.5}, {L: -1, R: 0}}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				r := wavebin.NewPCMReader[wavebin.IEEEFloat32BitStereoSample](bytes.NewReader(tt.rawBytes), wavebin.IEEEFloat32BitStereoSampleParser{})
+
+				var samples []wavebin.IEEEFloat32BitStereoSample
+				for {
+					sample, err := r.ReadSample()
+					if errors.Is(err, io.EOF) {
+						break
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					samples = append(samples, sample)
+				}
+
+				if df := cmp.Diff(tt.expected, samples); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+
+		t.Run("InvalidBytes", func(t *testing.T) {
+			r := wavebin.NewPCMReader[wavebin.IEEEFloat32BitStereoSample](bytes.NewReader([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}), wavebin.IEEEFloat32BitStereoSampleParser{})
+
+			_, err := r.ReadSample()
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	})
+	t.Run("64BitFloatMonoral", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			rawBytes []byte
+			expected []wavebin.IEEEFloat64BitMonoralSample
+		}{
+			{"Empty", []byte{}, nil},
+			{"64BitFloatMonoral1Sample", []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, []wavebin.IEEEFloat64BitMonoralSample{0}},
+			{"64BitFloatMonoral2Samples", []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0xbf}, []wavebin.IEEEFloat64BitMonoralSample{1, -0.5}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				r := wavebin.NewPCMReader[wavebin.IEEEFloat64BitMonoralSample](bytes.NewReader(tt.rawBytes), wavebin.IEEEFloat64BitMonoralSampleParser{})
+
+				var samples []wavebin.IEEEFloat64BitMonoralSample
+				for {
+					sample, err := r.ReadSample()
+					if errors.Is(err, io.EOF) {
+						break
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					samples = append(samples, sample)
+				}
+
+				if df := cmp.Diff(tt.expected, samples); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+
+		t.Run("InvalidBytes", func(t *testing.T) {
+			r := wavebin.NewPCMReader[wavebin.IEEEFloat64BitMonoralSample](bytes.NewReader([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}), wavebin.IEEEFloat64BitMonoralSampleParser{})
+
+			_, err := r.ReadSample()
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	})
+	t.Run("64BitFloatStereo", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			rawBytes []byte
+			expected []wavebin.IEEEFloat64BitStereoSample
+		}{
+			{"Empty", []byte{}, nil},
+			{"64BitFloatStereo1Sample", []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0xbf}, []wavebin.IEEEFloat64BitStereoSample{{L: 1, R: -0.5}}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				r := wavebin.NewPCMReader[wavebin.IEEEFloat64BitStereoSample](bytes.NewReader(tt.rawBytes), wavebin.IEEEFloat64BitStereoSampleParser{})
+
+				var samples []wavebin.IEEEFloat64BitStereoSample
+				for {
+					sample, err := r.ReadSample()
+					if errors.Is(err, io.EOF) {
+						break
+					} else if err != nil {
+						t.Fatal(err)
+					}
+
+					samples = append(samples, sample)
+				}
+
+				if df := cmp.Diff(tt.expected, samples); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+
+		t.Run("InvalidBytes", func(t *testing.T) {
+			r := wavebin.NewPCMReader[wavebin.IEEEFloat64BitStereoSample](bytes.NewReader([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}), wavebin.IEEEFloat64BitStereoSampleParser{})
+
+			_, err := r.ReadSample()
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	})
+}
+
+// readerOnlyParser hides PCMSampleBytesParser of the underlying parser.
+type readerOnlyParser[T wavebin.PCMSample] struct {
+	wavebin.PCMSampleParser[T]
+}
+
+func TestPCMReader_ReadSamples(t *testing.T) {
+	rawBytes := make([]byte, 4*10000)
+	expected := make([]wavebin.PCM16BitStereoSample, 10000)
+	for i := range expected {
+		expected[i] = wavebin.PCM16BitStereoSample{L: int16(i), R: int16(-i)}
+		expected[i].PutSamples(rawBytes[i*4 : (i+1)*4])
+	}
+
+	for _, tt := range []struct {
+		name   string
+		parser wavebin.PCMSampleParser[wavebin.PCM16BitStereoSample]
+		size   int
+	}{
+		{"Bytes1", wavebin.PCM16BitStereoSampleParser{}, 1},
+		{"Bytes3", wavebin.PCM16BitStereoSampleParser{}, 3},
+		{"Bytes4096", wavebin.PCM16BitStereoSampleParser{}, 4096},
+		{"Bytes20000", wavebin.PCM16BitStereoSampleParser{}, 20000},
+		{"Reader3", readerOnlyParser[wavebin.PCM16BitStereoSample]{wavebin.PCM16BitStereoSampleParser{}}, 3},
+		{"Reader4096", readerOnlyParser[wavebin.PCM16BitStereoSample]{wavebin.PCM16BitStereoSampleParser{}}, 4096},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r := wavebin.NewPCMReader(bytes.NewReader(rawBytes), tt.parser)
+
+			var samples []wavebin.PCM16BitStereoSample
+			dst := make([]wavebin.PCM16BitStereoSample, tt.size)
+			for {
+				n, err := r.ReadSamples(dst)
+				samples = append(samples, dst[:n]...)
+				if errors.Is(err, io.EOF) {
+					if n != 0 {
+						t.Errorf("unexpected samples with EOF: %d", n)
+					}
+					break
+				} else if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if df := cmp.Diff(expected, samples); df != "" {
+				t.Error(df)
+			}
+		})
+	}
+
+	t.Run("InvalidBytes", func(t *testing.T) {
+		r := wavebin.NewPCMReader[wavebin.PCM16BitStereoSample](bytes.NewReader(rawBytes[:10]), wavebin.PCM16BitStereoSampleParser{})
+
+		dst := make([]wavebin.PCM16BitStereoSample, 4)
+		n, err := r.ReadSamples(dst)
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("unexpected err: %v", err)
+		}
+		if n != 2 {
+			t.Errorf("unexpected samples: %d", n)
+		}
+	})
+}
+
+func BenchmarkPCMReader(b *testing.B) {
+	rawBytes := make([]byte, 4*44100)
+	b.Run("ReadSample", func(b *testing.B) {
+		b.SetBytes(int64(len(rawBytes)))
+		for i := 0; i < b.N; i++ {
+			r := wavebin.NewPCMReader[wavebin.PCM16BitStereoSample](bytes.NewReader(rawBytes), wavebin.PCM16BitStereoSampleParser{})
+			for {
+				_, err := r.ReadSample()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("ReadSamples", func(b *testing.B) {
+		b.SetBytes(int64(len(rawBytes)))
+		dst := make([]wavebin.PCM16BitStereoSample, 4096)
+		for i := 0; i < b.N; i++ {
+			r := wavebin.NewPCMReader[wavebin.PCM16BitStereoSample](bytes.NewReader(rawBytes), wavebin.PCM16BitStereoSampleParser{})
+			for {
+				_, err := r.ReadSamples(dst)
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
 }
@@ -15,6 +15,13 @@ type PCMSampleParser[T PCMSample] interface {
 	ParseFromReader(r io.Reader) (T, error)
 }
 
+// PCMSampleBytesParser is an optional interface of PCMSampleParser to parse the samples from the bytes of ByteSize.
+// PCMReader uses it to read many samples at once.
+type PCMSampleBytesParser[T PCMSample] interface {
+	ByteSize() int
+	ParseFromBytes(b []byte) T
+}
+
 type PCM8BitMonoralSample uint8
 
 func (s PCM8BitMonoralSample) PutSamples(p []byte) {
@@ -27,16 +34,27 @@ func (s PCM8BitMonoralSample) ByteSize() int {
 
 type PCM8BitMonoralSampleParser struct{}
 
-var _ PCMSampleParser[PCM8BitMonoralSample] = PCM8BitMonoralSampleParser{}
+var (
+	_ PCMSampleParser[PCM8BitMonoralSample]      = PCM8BitMonoralSampleParser{}
+	_ PCMSampleBytesParser[PCM8BitMonoralSample] = PCM8BitMonoralSampleParser{}
+)
 
-func (PCM8BitMonoralSampleParser) ParseFromReader(r io.Reader) (PCM8BitMonoralSample, error) {
+func (p PCM8BitMonoralSampleParser) ParseFromReader(r io.Reader) (PCM8BitMonoralSample, error) {
 	var b [1]byte
 	_, err := io.ReadFull(r, b[:])
 	if err != nil {
 		return 0, err
 	}
 
-	return PCM8BitMonoralSample(b[0]), nil
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (PCM8BitMonoralSampleParser) ByteSize() int {
+	return 1
+}
+
+func (PCM8BitMonoralSampleParser) ParseFromBytes(b []byte) PCM8BitMonoralSample {
+	return PCM8BitMonoralSample(b[0])
 }
 
 type PCM8BitStereoSample struct{ L, R uint8 }
@@ -53,16 +71,28 @@ func (s PCM8BitStereoSample) ByteSize() int {
 
 type PCM8BitStereoSampleParser struct{}
 
-var _ PCMSampleParser[PCM8BitStereoSample] = PCM8BitStereoSampleParser{}
+var (
+	_ PCMSampleParser[PCM8BitStereoSample]      = PCM8BitStereoSampleParser{}
+	_ PCMSampleBytesParser[PCM8BitStereoSample] = PCM8BitStereoSampleParser{}
+)
 
-func (PCM8BitStereoSampleParser) ParseFromReader(r io.Reader) (PCM8BitStereoSample, error) {
+func (p PCM8BitStereoSampleParser) ParseFromReader(r io.Reader) (PCM8BitStereoSample, error) {
 	var b [2]byte
 	_, err := io.ReadFull(r, b[:])
 	if err != nil {
 		return PCM8BitStereoSample{}, err
 	}
 
-	return PCM8BitStereoSample{L: b[0], R: b[1]}, nil
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (PCM8BitStereoSampleParser) ByteSize() int {
+	return 2
+}
+
+func (PCM8BitStereoSampleParser) ParseFromBytes(b []byte) PCM8BitStereoSample {
+	_ = b[1] // early bounds check to guarantee safety of reads below
+	return PCM8BitStereoSample{L: b[0], R: b[1]}
 }
 
 type PCM16BitMonoralSample int16
@@ -78,16 +108,28 @@ func (s PCM16BitMonoralSample) ByteSize() int {
 
 type PCM16BitMonoralSampleParser struct{}
 
-var _ PCMSampleParser[PCM16BitMonoralSample] = PCM16BitMonoralSampleParser{}
+var (
+	_ PCMSampleParser[PCM16BitMonoralSample]      = PCM16BitMonoralSampleParser{}
+	_ PCMSampleBytesParser[PCM16BitMonoralSample] = PCM16BitMonoralSampleParser{}
+)
 
-func (PCM16BitMonoralSampleParser) ParseFromReader(r io.Reader) (PCM16BitMonoralSample, error) {
+func (p PCM16BitMonoralSampleParser) ParseFromReader(r io.Reader) (PCM16BitMonoralSample, error) {
 	var b [2]byte
 	_, err := io.ReadFull(r, b[:])
 	if err != nil {
 		return 0, err
 	}
 
-	return PCM16BitMonoralSample(decode16bitSignedInt(binary.LittleEndian.Uint16(b[:]))), nil
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (PCM16BitMonoralSampleParser) ByteSize() int {
+	return 2
+}
+
+func (PCM16BitMonoralSampleParser) ParseFromBytes(b []byte) PCM16BitMonoralSample {
+	_ = b[1] // early bounds check to guarantee safety of reads below
+	return PCM16BitMonoralSample(decode16bitSignedInt(binary.LittleEndian.Uint16(b)))
 }
 
 type PCM16BitStereoSample struct{ L, R int16 }
@@ -104,19 +146,343 @@ func (s PCM16BitStereoSample) ByteSize() int {
 
 type PCM16BitStereoSampleParser struct{}
 
-var _ PCMSampleParser[PCM16BitStereoSample] = PCM16BitStereoSampleParser{}
+var (
+	_ PCMSampleParser[PCM16BitStereoSample]      = PCM16BitStereoSampleParser{}
+	_ PCMSampleBytesParser[PCM16BitStereoSample] = PCM16BitStereoSampleParser{}
+)
 
-func (PCM16BitStereoSampleParser) ParseFromReader(r io.Reader) (PCM16BitStereoSample, error) {
+func (p PCM16BitStereoSampleParser) ParseFromReader(r io.Reader) (PCM16BitStereoSample, error) {
 	var b [4]byte
 	_, err := io.ReadFull(r, b[:])
 	if err != nil {
 		return PCM16BitStereoSample{}, err
 	}
 
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (PCM16BitStereoSampleParser) ByteSize() int {
+	return 4
+}
+
+func (PCM16BitStereoSampleParser) ParseFromBytes(b []byte) PCM16BitStereoSample {
+	_ = b[3] // early bounds check to guarantee safety of reads below
 	return PCM16BitStereoSample{
 		L: decode16bitSignedInt(binary.LittleEndian.Uint16(b[0:2])),
 		R: decode16bitSignedInt(binary.LittleEndian.Uint16(b[2:4])),
-	}, nil
+	}
+}
+
+type PCM24BitMonoralSample int32
+
+func (s PCM24BitMonoralSample) PutSamples(p []byte) {
+	_ = p[2] // early bounds check to guarantee safety of writes below
+	putUint24(p, encode24bitSignedInt(int32(s)))
+}
+
+func (s PCM24BitMonoralSample) ByteSize() int {
+	return 3
+}
+
+type PCM24BitMonoralSampleParser struct{}
+
+var (
+	_ PCMSampleParser[PCM24BitMonoralSample]      = PCM24BitMonoralSampleParser{}
+	_ PCMSampleBytesParser[PCM24BitMonoralSample] = PCM24BitMonoralSampleParser{}
+)
+
+func (p PCM24BitMonoralSampleParser) ParseFromReader(r io.Reader) (PCM24BitMonoralSample, error) {
+	var b [3]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return 0, err
+	}
+
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (PCM24BitMonoralSampleParser) ByteSize() int {
+	return 3
+}
+
+func (PCM24BitMonoralSampleParser) ParseFromBytes(b []byte) PCM24BitMonoralSample {
+	_ = b[2] // early bounds check to guarantee safety of reads below
+	return PCM24BitMonoralSample(decode24bitSignedInt(uint24(b)))
+}
+
+type PCM24BitStereoSample struct{ L, R int32 }
+
+func (s PCM24BitStereoSample) PutSamples(p []byte) {
+	_ = p[5] // early bounds check to guarantee safety of writes below
+	putUint24(p[0:3], encode24bitSignedInt(s.L))
+	putUint24(p[3:6], encode24bitSignedInt(s.R))
+}
+
+func (s PCM24BitStereoSample) ByteSize() int {
+	return 6
+}
+
+type PCM24BitStereoSampleParser struct{}
+
+var (
+	_ PCMSampleParser[PCM24BitStereoSample]      = PCM24BitStereoSampleParser{}
+	_ PCMSampleBytesParser[PCM24BitStereoSample] = PCM24BitStereoSampleParser{}
+)
+
+func (p PCM24BitStereoSampleParser) ParseFromReader(r io.Reader) (PCM24BitStereoSample, error) {
+	var b [6]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return PCM24BitStereoSample{}, err
+	}
+
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (PCM24BitStereoSampleParser) ByteSize() int {
+	return 6
+}
+
+func (PCM24BitStereoSampleParser) ParseFromBytes(b []byte) PCM24BitStereoSample {
+	_ = b[5] // early bounds check to guarantee safety of reads below
+	return PCM24BitStereoSample{
+		L: decode24bitSignedInt(uint24(b[0:3])),
+		R: decode24bitSignedInt(uint24(b[3:6])),
+	}
+}
+
+type PCM32BitMonoralSample int32
+
+func (s PCM32BitMonoralSample) PutSamples(p []byte) {
+	_ = p[3] // early bounds check to guarantee safety of writes below
+	binary.LittleEndian.PutUint32(p, encode32bitSignedInt(int32(s)))
+}
+
+func (s PCM32BitMonoralSample) ByteSize() int {
+	return 4
+}
+
+type PCM32BitMonoralSampleParser struct{}
+
+var (
+	_ PCMSampleParser[PCM32BitMonoralSample]      = PCM32BitMonoralSampleParser{}
+	_ PCMSampleBytesParser[PCM32BitMonoralSample] = PCM32BitMonoralSampleParser{}
+)
+
+func (p PCM32BitMonoralSampleParser) ParseFromReader(r io.Reader) (PCM32BitMonoralSample, error) {
+	var b [4]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return 0, err
+	}
+
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (PCM32BitMonoralSampleParser) ByteSize() int {
+	return 4
+}
+
+func (PCM32BitMonoralSampleParser) ParseFromBytes(b []byte) PCM32BitMonoralSample {
+	_ = b[3] // early bounds check to guarantee safety of reads below
+	return PCM32BitMonoralSample(decode32bitSignedInt(binary.LittleEndian.Uint32(b)))
+}
+
+type PCM32BitStereoSample struct{ L, R int32 }
+
+func (s PCM32BitStereoSample) PutSamples(p []byte) {
+	_ = p[7] // early bounds check to guarantee safety of writes below
+	binary.LittleEndian.PutUint32(p[0:4], encode32bitSignedInt(s.L))
+	binary.LittleEndian.PutUint32(p[4:8], encode32bitSignedInt(s.R))
+}
+
+func (s PCM32BitStereoSample) ByteSize() int {
+	return 8
+}
+
+type PCM32BitStereoSampleParser struct{}
+
+var (
+	_ PCMSampleParser[PCM32BitStereoSample]      = PCM32BitStereoSampleParser{}
+	_ PCMSampleBytesParser[PCM32BitStereoSample] = PCM32BitStereoSampleParser{}
+)
+
+func (p PCM32BitStereoSampleParser) ParseFromReader(r io.Reader) (PCM32BitStereoSample, error) {
+	var b [8]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return PCM32BitStereoSample{}, err
+	}
+
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (PCM32BitStereoSampleParser) ByteSize() int {
+	return 8
+}
+
+func (PCM32BitStereoSampleParser) ParseFromBytes(b []byte) PCM32BitStereoSample {
+	_ = b[7] // early bounds check to guarantee safety of reads below
+	return PCM32BitStereoSample{
+		L: decode32bitSignedInt(binary.LittleEndian.Uint32(b[0:4])),
+		R: decode32bitSignedInt(binary.LittleEndian.Uint32(b[4:8])),
+	}
+}
+
+type IEEEFloat32BitMonoralSample float32
+
+func (s IEEEFloat32BitMonoralSample) PutSamples(p []byte) {
+	_ = p[3] // early bounds check to guarantee safety of writes below
+	binary.LittleEndian.PutUint32(p, math.Float32bits(float32(s)))
+}
+
+func (s IEEEFloat32BitMonoralSample) ByteSize() int {
+	return 4
+}
+
+type IEEEFloat32BitMonoralSampleParser struct{}
+
+var (
+	_ PCMSampleParser[IEEEFloat32BitMonoralSample]      = IEEEFloat32BitMonoralSampleParser{}
+	_ PCMSampleBytesParser[IEEEFloat32BitMonoralSample] = IEEEFloat32BitMonoralSampleParser{}
+)
+
+func (p IEEEFloat32BitMonoralSampleParser) ParseFromReader(r io.Reader) (IEEEFloat32BitMonoralSample, error) {
+	var b [4]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return 0, err
+	}
+
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (IEEEFloat32BitMonoralSampleParser) ByteSize() int {
+	return 4
+}
+
+func (IEEEFloat32BitMonoralSampleParser) ParseFromBytes(b []byte) IEEEFloat32BitMonoralSample {
+	_ = b[3] // early bounds check to guarantee safety of reads below
+	return IEEEFloat32BitMonoralSample(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+}
+
+type IEEEFloat32BitStereoSample struct{ L, R float32 }
+
+func (s IEEEFloat32BitStereoSample) PutSamples(p []byte) {
+	_ = p[7] // early bounds check to guarantee safety of writes below
+	binary.LittleEndian.PutUint32(p[0:4], math.Float32bits(s.L))
+	binary.LittleEndian.PutUint32(p[4:8], math.Float32bits(s.R))
+}
+
+func (s IEEEFloat32BitStereoSample) ByteSize() int {
+	return 8
+}
+
+type IEEEFloat32BitStereoSampleParser struct{}
+
+var (
+	_ PCMSampleParser[IEEEFloat32BitStereoSample]      = IEEEFloat32BitStereoSampleParser{}
+	_ PCMSampleBytesParser[IEEEFloat32BitStereoSample] = IEEEFloat32BitStereoSampleParser{}
+)
+
+func (p IEEEFloat32BitStereoSampleParser) ParseFromReader(r io.Reader) (IEEEFloat32BitStereoSample, error) {
+	var b [8]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return IEEEFloat32BitStereoSample{}, err
+	}
+
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (IEEEFloat32BitStereoSampleParser) ByteSize() int {
+	return 8
+}
+
+func (IEEEFloat32BitStereoSampleParser) ParseFromBytes(b []byte) IEEEFloat32BitStereoSample {
+	_ = b[7] // early bounds check to guarantee safety of reads below
+	return IEEEFloat32BitStereoSample{
+		L: math.Float32frombits(binary.LittleEndian.Uint32(b[0:4])),
+		R: math.Float32frombits(binary.LittleEndian.Uint32(b[4:8])),
+	}
+}
+
+type IEEEFloat64BitMonoralSample float64
+
+func (s IEEEFloat64BitMonoralSample) PutSamples(p []byte) {
+	_ = p[7] // early bounds check to guarantee safety of writes below
+	binary.LittleEndian.PutUint64(p, math.Float64bits(float64(s)))
+}
+
+func (s IEEEFloat64BitMonoralSample) ByteSize() int {
+	return 8
+}
+
+type IEEEFloat64BitMonoralSampleParser struct{}
+
+var (
+	_ PCMSampleParser[IEEEFloat64BitMonoralSample]      = IEEEFloat64BitMonoralSampleParser{}
+	_ PCMSampleBytesParser[IEEEFloat64BitMonoralSample] = IEEEFloat64BitMonoralSampleParser{}
+)
+
+func (p IEEEFloat64BitMonoralSampleParser) ParseFromReader(r io.Reader) (IEEEFloat64BitMonoralSample, error) {
+	var b [8]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return 0, err
+	}
+
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (IEEEFloat64BitMonoralSampleParser) ByteSize() int {
+	return 8
+}
+
+func (IEEEFloat64BitMonoralSampleParser) ParseFromBytes(b []byte) IEEEFloat64BitMonoralSample {
+	_ = b[7] // early bounds check to guarantee safety of reads below
+	return IEEEFloat64BitMonoralSample(math.Float64frombits(binary.LittleEndian.Uint64(b)))
+}
+
+type IEEEFloat64BitStereoSample struct{ L, R float64 }
+
+func (s IEEEFloat64BitStereoSample) PutSamples(p []byte) {
+	_ = p[15] // early bounds check to guarantee safety of writes below
+	binary.LittleEndian.PutUint64(p[0:8], math.Float64bits(s.L))
+	binary.LittleEndian.PutUint64(p[8:16], math.Float64bits(s.R))
+}
+
+func (s IEEEFloat64BitStereoSample) ByteSize() int {
+	return 16
+}
+
+type IEEEFloat64BitStereoSampleParser struct{}
+
+var (
+	_ PCMSampleParser[IEEEFloat64BitStereoSample]      = IEEEFloat64BitStereoSampleParser{}
+	_ PCMSampleBytesParser[IEEEFloat64BitStereoSample] = IEEEFloat64BitStereoSampleParser{}
+)
+
+func (p IEEEFloat64BitStereoSampleParser) ParseFromReader(r io.Reader) (IEEEFloat64BitStereoSample, error) {
+	var b [16]byte
+	_, err := io.ReadFull(r, b[:])
+	if err != nil {
+		return IEEEFloat64BitStereoSample{}, err
+	}
+
+	return p.ParseFromBytes(b[:]), nil
+}
+
+func (IEEEFloat64BitStereoSampleParser) ByteSize() int {
+	return 16
+}
+
+func (IEEEFloat64BitStereoSampleParser) ParseFromBytes(b []byte) IEEEFloat64BitStereoSample {
+	_ = b[15] // early bounds check to guarantee safety of reads below
+	return IEEEFloat64BitStereoSample{
+		L: math.Float64frombits(binary.LittleEndian.Uint64(b[0:8])),
+		R: math.Float64frombits(binary.LittleEndian.Uint64(b[8:16])),
+	}
 }
 
 func encode16bitSignedInt(s16 int16) (u16 uint16) {
@@ -139,3 +505,36 @@ func decode16bitSignedInt(u16 uint16) (s16 int16) {
 	s16 |= int16(u16 & 32767) // ^uint16(1<<15)
 	return
 }
+
+// encode24bitSignedInt encodes the lower 24 bits of s32 as 2's complement.
+// Values out of the 24bit range are truncated.
+func encode24bitSignedInt(s32 int32) (u32 uint32) {
+	return uint32(s32) & 0xFFFFFF
+}
+
+func decode24bitSignedInt(u32 uint32) (s32 int32) {
+	if negative := (u32 & (1 << 23)) != 0; negative {
+		u32 |= 0xFF000000 // sign extension
+	}
+	return int32(u32)
+}
+
+func encode32bitSignedInt(s32 int32) (u32 uint32) {
+	return uint32(s32)
+}
+
+func decode32bitSignedInt(u32 uint32) (s32 int32) {
+	return int32(u32)
+}
+
+func putUint24(p []byte, u32 uint32) {
+	_ = p[2] // early bounds check to guarantee safety of writes below
+	p[0] = byte(u32)
+	p[1] = byte(u32 >> 8)
+	p[2] = byte(u32 >> 16)
+}
+
+func uint24(p []byte) uint32 {
+	_ = p[2] // bounds check hint to compiler
+	return uint32(p[0]) | uint32(p[1])<<8 | uint32(p[2])<<16
+}
@@ -1,36 +1,63 @@
 package wavebin
 
-import (
-	"io"
-)
+import "io"
 
 type PCMWriter[T PCMSample] struct {
 	W io.Writer
+
+	// buf is reused to encode many samples at once.
+	buf []byte
+}
+
+// validatableSample is implemented by the samples which can't be encoded depending on their values.
+type validatableSample interface {
+	validate() error
 }
 
+// WriteSamples encodes the samples into the buffer and writes them to W at once for each pcmBufferSize bytes.
+// It returns ErrUnsupportedFormat without writing anything if any of the samples can't be encoded.
 func (w *PCMWriter[T]) WriteSamples(samples ...T) (n int64, err error) {
-	var buf [4]byte
-	var bufLen int
 	for _, sample := range samples {
-		if len(buf)-bufLen < sample.ByteSize() {
-			// flush
-			var nn int
-			nn, err = w.W.Write(buf[:bufLen])
-			n += int64(nn)
-			if err != nil {
-				return
+		if v, ok := any(sample).(validatableSample); ok {
+			if err := v.validate(); err != nil {
+				return 0, err
 			}
+		}
+	}
 
-			bufLen = 0
+	if w.buf == nil {
+		w.buf = make([]byte, 0, pcmBufferSize)
+	}
+
+	buf := w.buf[:0]
+	for _, sample := range samples {
+		size := sample.ByteSize()
+		if cap(buf)-len(buf) < size {
+			if len(buf) > 0 {
+				// flush
+				var nn int
+				nn, err = w.W.Write(buf)
+				n += int64(nn)
+				if err != nil {
+					return
+				}
+			}
+
+			if cap(buf) < size {
+				// the sample is larger than the buffer (e.g. many channels)
+				buf = make([]byte, 0, size)
+				w.buf = buf
+			}
+			buf = buf[:0]
 		}
 
-		sample.PutSamples(buf[bufLen:])
-		bufLen += sample.ByteSize()
+		sample.PutSamples(buf[len(buf) : len(buf)+size])
+		buf = buf[:len(buf)+size]
 	}
-	if bufLen > 0 {
+	if len(buf) > 0 {
 		// flush
 		var nn int
-		nn, err = w.W.Write(buf[:bufLen])
+		nn, err = w.W.Write(buf)
 		n += int64(nn)
 	}
 
@@ -120,6 +120,266 @@ func TestPCMWriter(t *testing.T) {
 			})
 		}
 	})
+	t.Run("24BitMonoral", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			samples  []wavebin.PCM24BitMonoralSample
+			expected []byte
+		}{
+			{"Empty", []wavebin.PCM24BitMonoralSample{}, nil},
+			{"24BitMonoral1Sample", []wavebin.PCM24BitMonoralSample{0}, []byte{0x00, 0x00, 0x00}},
+			{"24BitMonoral2Samples", []wavebin.PCM24BitMonoralSample{-1, 1}, []byte{0xff, 0xff, 0xff, 0x01, 0x00, 0x00}},
+			{"24BitMonoral3Samples", []wavebin.PCM24BitMonoralSample{8388607, -8388608, 0x123456}, []byte{0xff, 0xff, 0x7f, 0x00, 0x00, 0x80, 0x56, 0x34, 0x12}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.PCM24BitMonoralSample]{W: &buf}
+
+				_, err := w.WriteSamples(tt.samples...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if df := cmp.Diff(tt.expected, buf.Bytes()); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+	})
+	t.Run("24BitStereo", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			samples  []wavebin.PCM24BitStereoSample
+			expected []byte
+		}{
+			{"Empty", []wavebin.PCM24BitStereoSample{}, nil},
+			{"24BitStereo1Sample", []wavebin.PCM24BitStereoSample{{L: -1, R: 1}}, []byte{0xff, 0xff, 0xff, 0x01, 0x00, 0x00}},
+			{"24BitStereo2Sample", []wavebin.PCM24BitStereoSample{{L: -1, R: 1}, {L: 2, R: -2}}, []byte{0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x02, 0x00, 0x00, 0xfe, 0xff, 0xff}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.PCM24BitStereoSample]{W: &buf}
+
+				_, err := w.WriteSamples(tt.samples...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if df := cmp.Diff(tt.expected, buf.Bytes()); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+	})
+	t.Run("32BitMonoral", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			samples  []wavebin.PCM32BitMonoralSample
+			expected []byte
+		}{
+			{"Empty", []wavebin.PCM32BitMonoralSample{}, nil},
+			{"32BitMonoral1Sample", []wavebin.PCM32BitMonoralSample{0}, []byte{0x00, 0x00, 0x00, 0x00}},
+			{"32BitMonoral2Samples", []wavebin.PCM32BitMonoralSample{-1, 1}, []byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x00}},
+			{"32BitMonoral3Samples", []wavebin.PCM32BitMonoralSample{2147483647, -2147483648, 0x12345678}, []byte{0xff, 0xff, 0xff, 0x7f, 0x00, 0x00, 0x00, 0x80, 0x78, 0x56, 0x34, 0x12}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.PCM32BitMonoralSample]{W: &buf}
+
+				_, err := w.WriteSamples(tt.samples...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if df := cmp.Diff(tt.expected, buf.Bytes()); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+	})
+	t.Run("32BitStereo", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			samples  []wavebin.PCM32BitStereoSample
+			expected []byte
+		}{
+			{"Empty", []wavebin.PCM32BitStereoSample{}, nil},
+			{"32BitStereo1Sample", []wavebin.PCM32BitStereoSample{{L: -1, R: 1}}, []byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x00}},
+			{"32BitStereo2Sample", []wavebin.PCM32BitStereoSample{{L: -1, R: 1}, {L: 2, R: -2}}, []byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0xfe, 0xff, 0xff, 0xff}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.PCM32BitStereoSample]{W: &buf}
+
+				_, err := w.WriteSamples(tt.samples...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if df := cmp.Diff(tt.expected, buf.Bytes()); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+	})
+	t.Run("32BitFloatMonoral", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			samples  []wavebin.IEEEFloat32BitMonoralSample
+			expected []byte
+		}{
+			{"Empty", []wavebin.IEEEFloat32BitMonoralSample{}, nil},
+			{"32BitFloatMonoral1Sample", []wavebin.IEEEFloat32BitMonoralSample{0}, []byte{0x00, 0x00, 0x00, 0x00}},
+			{"32BitFloatMonoral2Samples", []wavebin.IEEEFloat32BitMonoralSample{1, -0.5}, []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0xbf}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.IEEEFloat32BitMonoralSample]{W: &buf}
+
+				_, err := w.WriteSamples(tt.samples...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if df := cmp.Diff(tt.expected, buf.Bytes()); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+	})
+	t.Run("32BitFloatStereo", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			samples  []wavebin.IEEEFloat32BitStereoSample
+			expected []byte
+		}{
+			{"Empty", []wavebin.IEEEFloat32BitStereoSample{}, nil},
+			{"32BitFloatStereo1Sample", []wavebin.IEEEFloat32BitStereoSample{{L: 1, R: -0.5}}, []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0xbf}},
+			{"32BitFloatStereo2Sample", []wavebin.IEEEFloat32BitStereoSample{{L: 1, R: -0.5}, {L: -1, R: 0}}, []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0xbf, 0x00, 0x00, 0x80, 0xbf, 0x00, 0x00, 0x00, 0x00}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.IEEEFloat32BitStereoSample]{W: &buf}
+
+				_, err := w.WriteSamples(tt.samples...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if df := cmp.Diff(tt.expected, buf.Bytes()); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+	})
+	t.Run("64BitFloatMonoral", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			samples  []wavebin.IEEEFloat64BitMonoralSample
+			expected []byte
+		}{
+			{"Empty", []wavebin.IEEEFloat64BitMonoralSample{}, nil},
+			{"64BitFloatMonoral1Sample", []wavebin.IEEEFloat64BitMonoralSample{0}, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+			{"64BitFloatMonoral2Samples", []wavebin.IEEEFloat64BitMonoralSample{1, -0.5}, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0xbf}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.IEEEFloat64BitMonoralSample]{W: &buf}
+
+				_, err := w.WriteSamples(tt.samples...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if df := cmp.Diff(tt.expected, buf.Bytes()); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+	})
+	t.Run("64BitFloatStereo", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			samples  []wavebin.IEEEFloat64BitStereoSample
+			expected []byte
+		}{
+			{"Empty", []wavebin.IEEEFloat64BitStereoSample{}, nil},
+			{"64BitFloatStereo1Sample", []wavebin.IEEEFloat64BitStereoSample{{L: 1, R: -0.5}}, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0xbf}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				w := &wavebin.PCMWriter[wavebin.IEEEFloat64BitStereoSample]{W: &buf}
+
+				_, err := w.WriteSamples(tt.samples...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if df := cmp.Diff(tt.expected, buf.Bytes()); df != "" {
+					t.Error(df)
+				}
+			})
+		}
+	})
+}
+
+func TestPCMWriter_LargeSamples(t *testing.T) {
+	samples := make([]wavebin.PCM16BitStereoSample, 20000)
+	expected := make([]byte, 4*len(samples))
+	for i := range samples {
+		samples[i] = wavebin.PCM16BitStereoSample{L: int16(i), R: int16(-i)}
+		samples[i].PutSamples(expected[i*4 : (i+1)*4])
+	}
+
+	var buf bytes.Buffer
+	w := &wavebin.PCMWriter[wavebin.PCM16BitStereoSample]{W: &buf}
+	n, err := w.WriteSamples(samples[:1]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nn, err := w.WriteSamples(samples[1:]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n+nn != int64(len(expected)) {
+		t.Errorf("unexpected written bytes: %d", n+nn)
+	}
+	if !bytes.Equal(expected, buf.Bytes()) {
+		t.Error("unexpected bytes")
+	}
+}
+
+func BenchmarkPCMWriter(b *testing.B) {
+	samples := make([]wavebin.PCM16BitStereoSample, 44100)
+	size := 4 * len(samples)
+	b.Run("WriteSample", func(b *testing.B) {
+		b.SetBytes(int64(size))
+		var buf bytes.Buffer
+		buf.Grow(size)
+		w := &wavebin.PCMWriter[wavebin.PCM16BitStereoSample]{W: &buf}
+		for i := 0; i < b.N; i++ {
+			buf.Reset()
+			for _, sample := range samples {
+				_, err := w.WriteSamples(sample)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("WriteSamples", func(b *testing.B) {
+		b.SetBytes(int64(size))
+		var buf bytes.Buffer
+		buf.Grow(size)
+		w := &wavebin.PCMWriter[wavebin.PCM16BitStereoSample]{W: &buf}
+		for i := 0; i < b.N; i++ {
+			buf.Reset()
+			_, err := w.WriteSamples(samples...)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
 }
 
 func ExamplePCMWriter() {
@@ -0,0 +1,89 @@
+package wavebin
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// RawChunk is a sub-chunk with the payload as is. It's used to keep the unknown chunks.
+type RawChunk struct {
+	ID      [4]byte
+	Payload []byte
+}
+
+func (c *RawChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      c.ID,
+		Payload: c.Payload,
+	}
+}
+
+// SectionChunk is a sub-chunk with the payload on io.SectionReader. It's used to keep the unknown chunks read lazily.
+type SectionChunk struct {
+	ID      [4]byte
+	Section *io.SectionReader
+}
+
+// Chunk returns a new sub-chunk reading the section from the beginning, so the chunk can be written many times.
+func (c *SectionChunk) Chunk() riffbin.Chunk {
+	return &riffbin.InStreamSubChunk{
+		ID:            c.ID,
+		SectionReader: io.NewSectionReader(c.Section, 0, c.Section.Size()),
+	}
+}
+
+// RawListChunk is a LIST chunk with the sub-chunks as is. It's used to keep the unknown LIST chunks.
+type RawListChunk struct {
+	ListType [4]byte
+	Payload  []ChunkProvider
+}
+
+func (c *RawListChunk) Chunk() riffbin.Chunk {
+	payload := make([]riffbin.Chunk, len(c.Payload))
+	for i, p := range c.Payload {
+		payload[i] = p.Chunk()
+	}
+
+	return &riffbin.ListChunk{
+		ListType: c.ListType,
+		Payload:  payload,
+	}
+}
+
+// newRawChunk keeps the chunk to be written again.
+// *riffbin.InStreamSubChunk is kept as SectionChunk without loading on memory, and the other sub-chunks are read into memory.
+func newRawChunk(chunk riffbin.Chunk) (ChunkProvider, error) {
+	switch c := chunk.(type) {
+	case *riffbin.ListChunk:
+		rawListChunk := &RawListChunk{
+			ListType: c.ListType,
+			Payload:  make([]ChunkProvider, len(c.Payload)),
+		}
+		for i, p := range c.Payload {
+			raw, err := newRawChunk(p)
+			if err != nil {
+				return nil, fmt.Errorf("LIST[%s]: %w", string(c.ListType[:]), err)
+			}
+			rawListChunk.Payload[i] = raw
+		}
+
+		return rawListChunk, nil
+	case *riffbin.InStreamSubChunk:
+		return &SectionChunk{
+			ID:      c.ID,
+			Section: io.NewSectionReader(c.SectionReader, 0, c.Size()),
+		}, nil
+	case riffbin.SubChunk:
+		rawChunk := &RawChunk{Payload: make([]byte, c.BodySize())}
+		copy(rawChunk.ID[:], c.ChunkID())
+		if _, err := io.ReadFull(c, rawChunk.Payload); err != nil {
+			return nil, fmt.Errorf("%s: %w", string(c.ChunkID()), err)
+		}
+
+		return rawChunk, nil
+	default:
+		return nil, fmt.Errorf("%s: %w", string(chunk.ChunkID()), ErrUnexpectedChunkType)
+	}
+}
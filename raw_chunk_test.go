@@ -0,0 +1,136 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+// readSectionChunk compares *wavebin.SectionChunk with *wavebin.RawChunk by reading the section.
+var readSectionChunk = cmp.FilterValues(func(x, y wavebin.ChunkProvider) bool {
+	_, xok := x.(*wavebin.SectionChunk)
+	_, yok := y.(*wavebin.SectionChunk)
+	return xok || yok
+}, cmp.Transformer("ReadSectionChunk", func(p wavebin.ChunkProvider) wavebin.ChunkProvider {
+	c, ok := p.(*wavebin.SectionChunk)
+	if !ok {
+		return p
+	}
+
+	payload, err := io.ReadAll(c.Chunk().(riffbin.SubChunk))
+	if err != nil {
+		panic(err)
+	}
+	return &wavebin.RawChunk{ID: c.ID, Payload: payload}
+}))
+
+func TestParseWaveChunks_Unknown(t *testing.T) {
+	unknownChunk := &wavebin.RawChunk{
+		ID:      [4]byte{'v', 'n', 'd', 'c'},
+		Payload: []byte("vendor"),
+	}
+	vendorListChunk := &wavebin.RawListChunk{
+		ListType: [4]byte{'v', 'n', 'd', 'r'},
+		Payload: []wavebin.ChunkProvider{
+			&wavebin.RawChunk{ID: [4]byte{'a', 'b', 'c', 'd'}, Payload: []byte{0x01, 0x02}},
+		},
+	}
+	adtlChunk := &wavebin.AssociatedDataListChunk{
+		Labels: []wavebin.CueLabel{{CuePointID: 1, Text: "Intro"}},
+		Unknown: []wavebin.ChunkProvider{
+			&wavebin.RawChunk{ID: [4]byte{'f', 'i', 'l', 'e'}, Payload: []byte{0x01, 0x00, 0x00, 0x00}},
+		},
+		Order: [][4]byte{{'f', 'i', 'l', 'e'}, {'l', 'a', 'b', 'l'}},
+	}
+	extras := []wavebin.ChunkProvider{
+		unknownChunk,
+		&wavebin.BextChunk{Description: "description"},
+		vendorListChunk,
+		adtlChunk,
+	}
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+	}
+
+	var src bytes.Buffer
+	_, err := riffbin.NewCompletedChunkWriter(&src).Write(wavebin.CreateCompletedRIFF(format, []byte{0x00, 0x01}, extras...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	riffChunk, err := wavebin.ReadWaveRIFF(io.MultiReader(bytes.NewReader(src.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chunks, err := wavebin.ParseWaveChunks(riffChunk, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if df := cmp.Diff([]wavebin.ChunkProvider{unknownChunk, vendorListChunk}, chunks.Unknown); df != "" {
+		t.Errorf("unknown chunks diff: %s", df)
+	}
+	if df := cmp.Diff(extras, chunks.Extras); df != "" {
+		t.Errorf("extras diff: %s", df)
+	}
+
+	samples, err := io.ReadAll(chunks.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst bytes.Buffer
+	_, err = riffbin.NewCompletedChunkWriter(&dst).Write(wavebin.CreateCompletedRIFF(chunks.Format, samples, chunks.Extras...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src.Bytes(), dst.Bytes()) {
+		t.Errorf("round-trip mismatch:\n%x\n%x", src.Bytes(), dst.Bytes())
+	}
+
+	t.Run("NotIgnored", func(t *testing.T) {
+		riffChunk, err := wavebin.ReadWaveRIFF(io.MultiReader(bytes.NewReader(src.Bytes())))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = wavebin.ParseWaveChunks(riffChunk, false)
+		if err == nil {
+			t.Error("should be error")
+		}
+	})
+	t.Run("Section", func(t *testing.T) {
+		// bytes.Reader implements riffbin.PartialReader, so the unknown chunks are read lazily
+		riffChunk, err := wavebin.ReadWaveRIFF(bytes.NewReader(src.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		chunks, err := wavebin.ParseWaveChunks(riffChunk, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := chunks.Unknown[0].(*wavebin.SectionChunk); !ok {
+			t.Errorf("unexpected unknown chunk: %T", chunks.Unknown[0])
+		}
+		if df := cmp.Diff([]wavebin.ChunkProvider{unknownChunk, vendorListChunk}, chunks.Unknown, readSectionChunk); df != "" {
+			t.Errorf("unknown chunks diff: %s", df)
+		}
+
+		// the chunks can be written many times
+		for i := 0; i < 2; i++ {
+			var dst bytes.Buffer
+			_, err = riffbin.NewCompletedChunkWriter(&dst).Write(wavebin.CreateCompletedRIFF(chunks.Format, samples, chunks.Extras...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(src.Bytes(), dst.Bytes()) {
+				t.Errorf("round-trip mismatch:\n%x\n%x", src.Bytes(), dst.Bytes())
+			}
+		}
+	})
+}
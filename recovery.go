@@ -0,0 +1,383 @@
+package wavebin
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// RecoveredWave is a WAVE binary recovered by RecoverWave or RepairWave.
+type RecoveredWave struct {
+	// Format is the format of the samples.
+	Format FormatChunk
+
+	// DataOffset is the offset of the data chunk body from the head of the RIFF chunk.
+	DataOffset int64
+
+	// DataSize is the recovered size of the data chunk body. It's a multiple of the block align.
+	DataSize int64
+
+	// SampleCount is the number of the sample frames in the data chunk.
+	SampleCount int64
+
+	// Repaired is true if the sizes in the header were broken.
+	Repaired bool
+
+	r       io.ReaderAt
+	id      [4]byte
+	head    int64
+	size    int64
+	patches []bytesPatch
+}
+
+// bytesPatch is the bytes to overwrite at the offset from the head of the RIFF chunk.
+type bytesPatch struct {
+	off int64
+	b   []byte
+}
+
+// RecoverWave scans the chunks of the truncated or crash-interrupted WAVE binary from the current position of r.
+// The zero, 0xFFFFFFFF placeholder or oversized length of the data chunk is recovered from the size of r,
+// and the incomplete sample frame at the end is dropped. Use Reader to read the recovered binary.
+// It returns an error if the chunks before the data chunk are broken.
+func RecoverWave(r io.ReadSeeker) (*RecoveredWave, error) {
+	head, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, fmt.Errorf("seek: %w", err)
+	}
+
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("seek: %w", err)
+	}
+	if _, err := r.Seek(head, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seek: %w", err)
+	}
+
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		ra = &seekReaderAt{r: r}
+	}
+
+	return scanWave(ra, head, end)
+}
+
+// RepairWave recovers the WAVE binary as well as RecoverWave, and rewrites the broken sizes in place.
+// If the recovered RIFF chunk exceeds 4GiB, the file is rewritten as RF64 only if it has a JUNK chunk reserved by CreateRF64SampleWriter.
+// If rw implements Truncate(int64) error (e.g. *os.File), the incomplete sample frame at the end is truncated.
+func RepairWave(rw io.ReadWriteSeeker) (*RecoveredWave, error) {
+	recovered, err := RecoverWave(rw)
+	if err != nil {
+		return nil, err
+	}
+	if !recovered.Repaired {
+		return recovered, nil
+	}
+
+	if recovered.id == riffBytes && uint64(recovered.size-riffbin.HeaderBytes) > maxRIFFSize {
+		// the RIFF chunk can't have the recovered size
+		if err := recovered.repairAsRF64(rw); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, patch := range recovered.patches {
+			if err := writeBytesAt(rw, recovered.head+patch.off, patch.b); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if t, ok := rw.(interface{ Truncate(int64) error }); ok {
+		if err := t.Truncate(recovered.head + recovered.size); err != nil {
+			return nil, fmt.Errorf("truncate: %w", err)
+		}
+	}
+
+	return recovered, nil
+}
+
+// Reader returns the recovered WAVE binary to be passed to ReadWaveRIFF or NewDecoder.
+func (w *RecoveredWave) Reader() riffbin.PartialReader {
+	return &patchedReader{r: w.r, base: w.head, size: w.size, patches: w.patches}
+}
+
+// repairAsRF64 rewrites the RIFF WAVE binary as RF64 by replacing the JUNK chunk with the ds64 chunk.
+func (w *RecoveredWave) repairAsRF64(rw io.ReadWriteSeeker) error {
+	var b [riffbin.HeaderBytes]byte
+	if _, err := w.r.ReadAt(b[:], w.head+riffbin.HeaderBytes+4); err != nil {
+		return invalidFormatError(err)
+	}
+
+	var id [4]byte
+	copy(id[:], b[:4])
+	if (id != junkBytes && id != upperJunkBytes) || binary.LittleEndian.Uint32(b[4:]) != ds64BodySize {
+		return fmt.Errorf("RIFF: %w: no space for ds64 chunk", ErrDataTooLarge)
+	}
+
+	lw := &largeSampleWriter{
+		w:          rw,
+		formType:   rf64Bytes,
+		head:       w.head,
+		dataHeader: w.head + w.DataOffset - riffbin.HeaderBytes,
+		blockAlign: w.Format.BlockAlign(),
+		written:    uint64(w.DataSize),
+	}
+	return lw.Close()
+}
+
+func scanWave(r io.ReaderAt, head, end int64) (*RecoveredWave, error) {
+	var b [riffbin.HeaderBytes + 4]byte
+	if _, err := r.ReadAt(b[:], head); err != nil {
+		return nil, invalidFormatError(err)
+	}
+
+	var id, formType [4]byte
+	copy(id[:], b[:4])
+	copy(formType[:], b[8:])
+	if id != riffBytes && id != rf64Bytes && id != bw64Bytes {
+		return nil, fmt.Errorf("%w: %s", riffbin.ErrInvalidFormat, string(id[:]))
+	}
+	if formType != waveBytes {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedFormType, string(formType[:]))
+	}
+	riffSize := uint64(binary.LittleEndian.Uint32(b[4:8]))
+
+	w := &RecoveredWave{r: r, id: id, head: head}
+	var (
+		ds64       *DataSize64Chunk
+		ds64Offset int64
+		dataHeader int64 = -1
+		lastEnd    int64
+	)
+	// withinRIFF returns true if the offset is within the declared size of the RIFF chunk.
+	withinRIFF := func(off int64) bool {
+		size := riffSize
+		if ds64 != nil && size == rf64PlaceholderSize {
+			size = ds64.RIFFSize
+		}
+		return uint64(off-head-riffbin.HeaderBytes) <= size
+	}
+	// skipPadByte returns the position of the next chunk and the end of the chunk including the pad byte within the RIFF chunk.
+	skipPadByte := func(chunkEnd, size int64) (next, last int64, err error) {
+		if size%2 == 0 || chunkEnd >= end {
+			return chunkEnd, chunkEnd, nil
+		}
+
+		// the pad byte is NUL, but some writers omit it and then the next chunk ID follows
+		var pad [1]byte
+		if _, err := r.ReadAt(pad[:], chunkEnd); err != nil {
+			return 0, 0, invalidFormatError(err)
+		}
+		if pad[0] != 0 {
+			return chunkEnd, chunkEnd, nil
+		}
+		if !withinRIFF(chunkEnd + 1) {
+			return chunkEnd + 1, chunkEnd, nil
+		}
+		return chunkEnd + 1, chunkEnd + 1, nil
+	}
+	pos := head + int64(len(b))
+	for pos+riffbin.HeaderBytes <= end {
+		var h [riffbin.HeaderBytes]byte
+		if _, err := r.ReadAt(h[:], pos); err != nil {
+			return nil, invalidFormatError(err)
+		}
+
+		var chunkID [4]byte
+		copy(chunkID[:], h[:4])
+		size32 := binary.LittleEndian.Uint32(h[4:])
+		size := int64(size32)
+		if size32 == rf64PlaceholderSize && ds64 != nil {
+			if s, ok := ds64.chunkSize(chunkID); ok {
+				size = int64(s)
+			}
+		}
+
+		body := pos + riffbin.HeaderBytes
+		available := end - body
+		if chunkID == dataBytes {
+			if w.Format == nil {
+				return nil, fmt.Errorf("%s[WAVE].fmt: %w", string(id[:]), ErrLackOfRequiredChunks)
+			}
+
+			blockAlign := int64(w.Format.BlockAlign())
+			if blockAlign == 0 {
+				return nil, fmt.Errorf("%s[WAVE].fmt: %w", string(id[:]), ErrUnexpectedBlockAlign)
+			}
+
+			dataHeader = pos
+			w.DataOffset = body - head
+			// the zero size is broken only if the data chunk is the last chunk within the RIFF size or the RIFF size is also inconsistent
+			brokenZero := size == 0 && available > 0 && (!withinRIFF(body+1) || !withinRIFF(end))
+			if brokenZero || (size32 == rf64PlaceholderSize && ds64 == nil) || size < 0 || size > available {
+				// the data chunk of the broken size is the last chunk
+				w.DataSize = available - available%blockAlign
+				w.SampleCount = w.DataSize / blockAlign
+				w.Repaired = true
+				lastEnd = body + w.DataSize
+				break
+			}
+
+			w.DataSize = size
+			w.SampleCount = size / blockAlign
+			next, last, err := skipPadByte(body+size, size)
+			if err != nil {
+				return nil, err
+			}
+			pos, lastEnd = next, last
+			continue
+		}
+		if size < 0 || size > available {
+			if dataHeader != -1 {
+				// drop the truncated chunk after the data chunk
+				w.Repaired = true
+				break
+			}
+			return nil, fmt.Errorf("%s: %w: %d bytes exceeds the file", string(chunkID[:]), ErrUnexpectedChunkSize, size)
+		}
+
+		switch {
+		case chunkID == ds64Bytes && pos == head+int64(len(b)) && id != riffBytes:
+			ds64 = &DataSize64Chunk{}
+			if _, err := ds64.ReadFrom(io.NewSectionReader(r, body, size)); err != nil {
+				return nil, fmt.Errorf("%s[WAVE].ds64: %w", string(id[:]), invalidFormatError(err))
+			}
+			ds64Offset = body - head
+		case chunkID == fmtBytes:
+			format, err := parseFormatChunk(&riffbin.InStreamSubChunk{ID: chunkID, SectionReader: io.NewSectionReader(r, body, size)})
+			if err != nil {
+				return nil, err
+			}
+			w.Format = format
+		}
+
+		next, last, err := skipPadByte(body+size, size)
+		if err != nil {
+			return nil, err
+		}
+		pos, lastEnd = next, last
+	}
+	if dataHeader == -1 {
+		return nil, fmt.Errorf("%s[WAVE].data: %w", string(id[:]), ErrLackOfRequiredChunks)
+	}
+	if id != riffBytes && ds64 == nil {
+		return nil, fmt.Errorf("%s[WAVE].ds64: %w", string(id[:]), ErrLackOfRequiredChunks)
+	}
+
+	w.size = lastEnd - head
+	newRIFFSize := uint64(w.size - riffbin.HeaderBytes)
+	if ds64 != nil && riffSize == rf64PlaceholderSize {
+		riffSize = ds64.RIFFSize
+	}
+	if riffSize != newRIFFSize {
+		w.Repaired = true
+	}
+	if !w.Repaired {
+		return w, nil
+	}
+
+	dataSizeOffset := dataHeader - head + 4
+	if ds64 != nil {
+		var b [24]byte
+		binary.LittleEndian.PutUint64(b[0:8], newRIFFSize)
+		binary.LittleEndian.PutUint64(b[8:16], uint64(w.DataSize))
+		binary.LittleEndian.PutUint64(b[16:24], uint64(w.SampleCount))
+		w.patches = []bytesPatch{
+			{off: 4, b: uint32Bytes(rf64PlaceholderSize)},
+			{off: ds64Offset, b: b[:]},
+			{off: dataSizeOffset, b: uint32Bytes(rf64PlaceholderSize)},
+		}
+	} else if newRIFFSize > maxRIFFSize {
+		// both sizes are unknown and the data chunk is read until the end of the recovered binary
+		w.patches = []bytesPatch{
+			{off: 4, b: uint32Bytes(rf64PlaceholderSize)},
+			{off: dataSizeOffset, b: uint32Bytes(rf64PlaceholderSize)},
+		}
+	} else {
+		w.patches = []bytesPatch{
+			{off: 4, b: uint32Bytes(uint32(newRIFFSize))},
+			{off: dataSizeOffset, b: uint32Bytes(uint32(w.DataSize))},
+		}
+	}
+
+	return w, nil
+}
+
+func uint32Bytes(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
+
+// patchedReader is riffbin.PartialReader to read r with the patches and the limited size.
+type patchedReader struct {
+	r       io.ReaderAt
+	base    int64
+	size    int64
+	pos     int64
+	patches []bytesPatch
+}
+
+func (r *patchedReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	limited := false
+	if remaining := r.size - off; int64(len(p)) > remaining {
+		p = p[:remaining]
+		limited = true
+	}
+
+	n, err := r.r.ReadAt(p, r.base+off)
+	for _, patch := range r.patches {
+		start, stop := patch.off, patch.off+int64(len(patch.b))
+		if stop <= off || start >= off+int64(n) {
+			continue
+		}
+
+		src := patch.b
+		if start < off {
+			src = src[off-start:]
+			start = off
+		}
+		copy(p[start-off:n], src)
+	}
+	if err == nil && limited {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *patchedReader) Read(p []byte) (int, error) {
+	n, err := r.ReadAt(p, r.pos)
+	r.pos += int64(n)
+	if err == io.EOF && n != 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *patchedReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	r.pos = offset
+	return offset, nil
+}
@@ -0,0 +1,296 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestRecoverWave(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+	}
+	infoChunk := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoArtistIART: "AAA"}}
+	samples := []byte{0x00, 0x80, 0xff, 0x7f, 0x00, 0x40, 0x00, 0x00}
+
+	for _, tt := range []struct {
+		name     string
+		write    func(f *os.File) error
+		repaired bool
+	}{
+		{
+			name: "Completed",
+			write: func(f *os.File) error {
+				w, err := wavebin.CreateSampleWriter(f, format, infoChunk)
+				if err != nil {
+					return err
+				}
+				if _, err := w.Write(samples); err != nil {
+					return err
+				}
+				return w.Close()
+			},
+			repaired: false,
+		},
+		{
+			name: "NotClosed",
+			write: func(f *os.File) error {
+				w, err := wavebin.CreateSampleWriter(f, format, infoChunk)
+				if err != nil {
+					return err
+				}
+				// with an incomplete sample frame
+				_, err = w.Write(append(samples, 0x01, 0x02))
+				return err
+			},
+			repaired: true,
+		},
+		{
+			name: "OddChunk",
+			write: func(f *os.File) error {
+				odd := &wavebin.RawChunk{ID: [4]byte{'o', 'd', 'd', ' '}, Payload: []byte{0x01, 0x02, 0x03}}
+				w, err := wavebin.CreateSampleWriter(f, format, odd, infoChunk)
+				if err != nil {
+					return err
+				}
+				_, err = w.Write(samples)
+				return err
+			},
+			repaired: true,
+		},
+		{
+			name: "Streaming",
+			write: func(f *os.File) error {
+				w, err := wavebin.CreateStreamingSampleWriter(f, wavebin.UnknownDataSize, format, infoChunk)
+				if err != nil {
+					return err
+				}
+				_, err = w.Write(samples)
+				return err
+			},
+			repaired: true,
+		},
+		{
+			name: "RF64NotClosed",
+			write: func(f *os.File) error {
+				defer wavebin.SetMaxRIFFSize(64)()
+
+				w, err := wavebin.CreateRF64SampleWriter(f, format, infoChunk)
+				if err != nil {
+					return err
+				}
+				if _, err := w.Write(samples); err != nil {
+					return err
+				}
+				if err := w.Close(); err != nil {
+					return err
+				}
+
+				// the sizes in ds64 chunk are not written yet, and with an incomplete sample frame
+				if _, err := f.WriteAt(make([]byte, 16), 20); err != nil {
+					return err
+				}
+				_, err = f.Write(samples[:3])
+				return err
+			},
+			repaired: true,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.CreateTemp("", "wavebin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			defer f.Close()
+
+			if err := tt.write(f); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				t.Fatal(err)
+			}
+
+			recovered, err := wavebin.RecoverWave(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if recovered.Repaired != tt.repaired {
+				t.Errorf("unexpected Repaired: %t", recovered.Repaired)
+			}
+			if recovered.DataSize != int64(len(samples)) {
+				t.Errorf("unexpected DataSize: %d", recovered.DataSize)
+			}
+			if recovered.SampleCount != 2 {
+				t.Errorf("unexpected SampleCount: %d", recovered.SampleCount)
+			}
+
+			decoder, err := wavebin.NewDecoder(recovered.Reader())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := io.ReadAll(decoder.Data())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(samples, got); diff != "" {
+				t.Errorf("unexpected samples: %s", diff)
+			}
+			if diff := cmp.Diff(infoChunk, decoder.Info()); diff != "" {
+				t.Errorf("unexpected info: %s", diff)
+			}
+		})
+	}
+
+	t.Run("BrokenFormat", func(t *testing.T) {
+		var b bytes.Buffer
+		if _, err := riffbin.NewCompletedChunkWriter(&b).Write(wavebin.CreateCompletedRIFF(format, samples)); err != nil {
+			t.Fatal(err)
+		}
+
+		// truncated in the fmt chunk
+		_, err := wavebin.RecoverWave(bytes.NewReader(b.Bytes()[:24]))
+		if !errors.Is(err, wavebin.ErrUnexpectedChunkSize) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestRepairWave_EmptyData(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+	}
+	infoChunk := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoArtistIART: "AAA"}}
+
+	f, err := os.CreateTemp("", "wavebin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	// the valid empty data chunk followed by LIST chunk
+	_, err = riffbin.NewCompletedChunkWriter(f).Write(&riffbin.RIFFChunk{
+		FormType: [4]byte{'W', 'A', 'V', 'E'},
+		Payload: []riffbin.Chunk{
+			format.Chunk(),
+			&riffbin.OnMemorySubChunk{ID: [4]byte{'d', 'a', 't', 'a'}, Payload: []byte{}},
+			infoChunk.Chunk(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	original, err := os.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+
+	recovered, err := wavebin.RepairWave(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recovered.Repaired {
+		t.Error("should not be repaired")
+	}
+	if recovered.DataSize != 0 {
+		t.Errorf("unexpected DataSize: %d", recovered.DataSize)
+	}
+
+	b, err := os.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(original, b) {
+		t.Error("should not be modified")
+	}
+
+	decoder, err := wavebin.NewDecoder(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(infoChunk, decoder.Info()); diff != "" {
+		t.Errorf("unexpected info: %s", diff)
+	}
+}
+
+func TestRepairWave(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+	}
+	samples := []byte{0x00, 0x80, 0xff, 0x7f, 0x00, 0x40, 0x00, 0x00}
+
+	for _, tt := range []struct {
+		name             string
+		create           func(io.WriteSeeker, wavebin.FormatChunk, ...wavebin.ChunkProvider) (io.WriteCloser, error)
+		maxRIFFSize      uint64
+		expectedFormType string
+	}{
+		{"RIFF", wavebin.CreateSampleWriter, 0xFFFFFFFF, "RIFF"},
+		{"RF64", wavebin.CreateRF64SampleWriter, 64, "RF64"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer wavebin.SetMaxRIFFSize(tt.maxRIFFSize)()
+
+			f, err := os.CreateTemp("", "wavebin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			defer f.Close()
+
+			w, err := tt.create(f, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := w.Write(append(samples, 0x01)); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				t.Fatal(err)
+			}
+
+			recovered, err := wavebin.RepairWave(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !recovered.Repaired {
+				t.Error("should be repaired")
+			}
+
+			b, err := os.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b[:4]) != tt.expectedFormType {
+				t.Errorf("unexpected form type: %s", string(b[:4]))
+			}
+			if int64(len(b)) != recovered.DataOffset+recovered.DataSize {
+				t.Errorf("not truncated: %d bytes", len(b))
+			}
+
+			decoder, err := wavebin.NewDecoder(bytes.NewReader(b))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := io.ReadAll(decoder.Data())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(samples, got); diff != "" {
+				t.Errorf("unexpected samples: %s", diff)
+			}
+		})
+	}
+}
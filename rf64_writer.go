@@ -0,0 +1,110 @@
+package wavebin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+const (
+	// ds64BodySize is the size of ds64 chunk body without the table.
+	ds64BodySize = 28
+
+	// maxDS64TableLength is the max number of the ds64 table entries to accept on reading.
+	maxDS64TableLength = 1024
+
+	// maxDS64Size is the max size of ds64 chunk body to accept on reading.
+	maxDS64Size = ds64BodySize + 12*maxDS64TableLength
+
+	// rf64PlaceholderSize is the 32bit size to indicate that the actual size is in the ds64 chunk.
+	rf64PlaceholderSize = 0xFFFFFFFF
+)
+
+// maxRIFFSize is the max size of the RIFF chunk body. It's a variable for testing.
+var maxRIFFSize uint64 = 0xFFFFFFFF
+
+// CreateRF64SampleWriter creates a sample writer as well as CreateSampleWriter, but it supports more than 4GiB samples.
+// It reserves a JUNK chunk for the ds64 chunk and the file is written as RF64 (EBU Tech 3306) on Close only if the RIFF chunk exceeds 4GiB.
+func CreateRF64SampleWriter(w io.WriteSeeker, format FormatChunk, extras ...ChunkProvider) (io.WriteCloser, error) {
+	return createLargeSampleWriter(w, rf64Bytes, format, extras...)
+}
+
+// CreateBW64SampleWriter creates a sample writer as well as CreateRF64SampleWriter, but it writes BW64 (ITU-R BS.2088) instead of RF64.
+func CreateBW64SampleWriter(w io.WriteSeeker, format FormatChunk, extras ...ChunkProvider) (io.WriteCloser, error) {
+	return createLargeSampleWriter(w, bw64Bytes, format, extras...)
+}
+
+func createLargeSampleWriter(w io.WriteSeeker, formType [4]byte, format FormatChunk, extras ...ChunkProvider) (io.WriteCloser, error) {
+	// JUNK chunk to reserve space for ds64 chunk
+	head, dataHeader, err := writeWaveHeader(w, ds64BodySize, format, extras...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &largeSampleWriter{
+		w:          w,
+		formType:   formType,
+		head:       head,
+		dataHeader: dataHeader,
+		blockAlign: format.BlockAlign(),
+	}, nil
+}
+
+type largeSampleWriter struct {
+	w          io.WriteSeeker
+	formType   [4]byte
+	head       int64
+	dataHeader int64
+	blockAlign uint16
+	written    uint64
+}
+
+func (w *largeSampleWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.written += uint64(n)
+	return n, err
+}
+
+func (w *largeSampleWriter) Close() error {
+	// RIFF chunk body is from the form type to the end of data chunk
+	riffSize := uint64(w.dataHeader-w.head) + w.written
+	if riffSize <= maxRIFFSize {
+		return finalizeWaveSizes(w.w, w.head, w.dataHeader, uint32(w.written))
+	}
+
+	var sampleCount uint64
+	if w.blockAlign != 0 {
+		sampleCount = w.written / uint64(w.blockAlign)
+	}
+
+	// RF64 header
+	var header [riffbin.HeaderBytes]byte
+	copy(header[:4], w.formType[:])
+	binary.LittleEndian.PutUint32(header[4:], rf64PlaceholderSize)
+	if err := writeBytesAt(w.w, w.head, header[:]); err != nil {
+		return fmt.Errorf("%s: %w", string(w.formType[:]), err)
+	}
+
+	// replace JUNK chunk with ds64 chunk
+	var ds64 bytes.Buffer
+	_, err := writeChunk(&ds64, (&DataSize64Chunk{
+		RIFFSize:    riffSize,
+		DataSize:    w.written,
+		SampleCount: sampleCount,
+	}).Chunk())
+	if err != nil {
+		return fmt.Errorf("%s[WAVE].ds64: %w", string(w.formType[:]), err)
+	}
+	if err := writeBytesAt(w.w, w.head+riffbin.HeaderBytes+4, ds64.Bytes()); err != nil {
+		return fmt.Errorf("%s[WAVE].ds64: %w", string(w.formType[:]), err)
+	}
+
+	if err := writeUint32At(w.w, w.dataHeader+4, rf64PlaceholderSize); err != nil {
+		return fmt.Errorf("%s[WAVE].data: %w", string(w.formType[:]), err)
+	}
+
+	return nil
+}
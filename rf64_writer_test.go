@@ -0,0 +1,136 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestCreateRF64SampleWriter(t *testing.T) {
+	samples := []byte{0x00, 0x80, 0xff, 0x7f, 0x00, 0x40, 0x00, 0x00}
+
+	for _, tt := range []struct {
+		name             string
+		create           func(io.WriteSeeker, wavebin.FormatChunk, ...wavebin.ChunkProvider) (io.WriteCloser, error)
+		maxRIFFSize      uint64
+		expectedFormType string
+	}{
+		{"RIFF", wavebin.CreateRF64SampleWriter, 0xFFFFFFFF, "RIFF"},
+		{"RF64", wavebin.CreateRF64SampleWriter, 64, "RF64"},
+		{"BW64", wavebin.CreateBW64SampleWriter, 64, "BW64"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer wavebin.SetMaxRIFFSize(tt.maxRIFFSize)()
+
+			f, err := os.CreateTemp("", "wavebin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			defer f.Close()
+
+			w, err := tt.create(f, &wavebin.ExtendedFormatChunk{
+				MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+			}, &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{
+				wavebin.InfoArtistIART: "AAA",
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = w.Write(samples)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = w.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := os.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b[:4]) != tt.expectedFormType {
+				t.Errorf("form type should be %s but got: %s", tt.expectedFormType, string(b[:4]))
+			}
+			if tt.expectedFormType == "RIFF" {
+				if size := binary.LittleEndian.Uint32(b[4:8]); size != uint32(len(b)-8) {
+					t.Errorf("RIFF size should be %d but got: %d", len(b)-8, size)
+				}
+				if string(b[12:16]) != "JUNK" {
+					t.Errorf("JUNK chunk should be reserved but got: %s", string(b[12:16]))
+				}
+			} else {
+				if size := binary.LittleEndian.Uint32(b[4:8]); size != 0xFFFFFFFF {
+					t.Errorf("RIFF size should be placeholder but got: %08X", size)
+				}
+				if string(b[12:16]) != "ds64" {
+					t.Errorf("ds64 chunk should be written but got: %s", string(b[12:16]))
+				}
+				if size := binary.LittleEndian.Uint64(b[20:28]); size != uint64(len(b)-8) {
+					t.Errorf("ds64 RIFF size should be %d but got: %d", len(b)-8, size)
+				}
+				if size := binary.LittleEndian.Uint64(b[28:36]); size != uint64(len(samples)) {
+					t.Errorf("ds64 data size should be %d but got: %d", len(samples), size)
+				}
+				if count := binary.LittleEndian.Uint64(b[36:44]); count != 2 {
+					t.Errorf("ds64 sample count should be 2 but got: %d", count)
+				}
+			}
+
+			for _, r := range []io.Reader{bytes.NewReader(b), io.MultiReader(bytes.NewReader(b))} {
+				riffChunk, err := wavebin.ReadWaveRIFF(r)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				_, infoChunk, _, sampleReader, err := wavebin.ParseWaveRIFF(riffChunk, false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if df := cmp.Diff(&wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoArtistIART: "AAA"}}, infoChunk); df != "" {
+					t.Errorf("info diff: %s", df)
+				}
+
+				got, err := io.ReadAll(sampleReader)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if df := cmp.Diff(samples, got); df != "" {
+					t.Errorf("samples diff: %s", df)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateSampleWriter_DataTooLarge(t *testing.T) {
+	defer wavebin.SetMaxRIFFSize(64)()
+
+	f, err := os.CreateTemp("", "wavebin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	w, err := wavebin.CreateSampleWriter(f, &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	_, err = w.Write(make([]byte, 64))
+	if !errors.Is(err, wavebin.ErrDataTooLarge) {
+		t.Errorf("unexpected err: %v", err)
+	}
+}
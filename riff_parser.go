@@ -19,71 +19,296 @@ var (
 	ErrUnknownChunk         = errors.New("unknown chunk")
 	ErrUnknownListType      = errors.New("unknown list type")
 	ErrLackOfRequiredChunks = errors.New("lack of required chunks")
+	ErrUnsupportedFormat    = errors.New("unsupported format")
+	ErrDataTooLarge         = errors.New("data too large")
+	ErrDuplicateChunk       = errors.New("duplicate chunk")
+	ErrInvalidFrameOffset   = errors.New("invalid frame offset")
+	ErrInconsistentFormat   = errors.New("inconsistent format")
 )
 
+// WaveChunks is the chunks of the parsed WAVE RIFF chunk.
+// The optional chunks are nil if the RIFF chunk does not have them.
+type WaveChunks struct {
+	Format FormatChunk
+	Info   *InfoChunk
+	Fact   *FactChunk
+	Bext   *BextChunk
+	Cue    *CueChunk
+	Smpl   *SamplerChunk
+	Inst   *InstrumentChunk
+	Cset   *CharacterSetChunk
+	IXML   *IXMLChunk
+	Cart   *CartChunk
+	Data   riffbin.SubChunk
+
+	AssociatedData *AssociatedDataListChunk
+
+	// Unknown is the unknown chunks in the original order. They are kept only if the unknown chunks are ignored.
+	Unknown []ChunkProvider
+
+	// Custom is the chunks decoded by ParseOptions or ChunkRegistry decoders in the original order.
+	Custom []ChunkProvider
+
+	// Extras is the chunks except fmt, data and JUNK chunks in the original order.
+	// It can be passed to CreateCompletedRIFF or the other writers as extras to write the chunks again.
+	Extras []ChunkProvider
+}
+
+func (c *WaveChunks) appendUnknown(chunk riffbin.Chunk) error {
+	raw, err := newRawChunk(chunk)
+	if err != nil {
+		return fmt.Errorf("RIFF[WAVE].%w", err)
+	}
+
+	c.Unknown = append(c.Unknown, raw)
+	c.Extras = append(c.Extras, raw)
+	return nil
+}
+
+func (c *WaveChunks) appendCustom(chunk riffbin.Chunk, decoder ChunkDecoder) error {
+	custom, err := decoder(chunk)
+	if err != nil {
+		return fmt.Errorf("RIFF[WAVE].%s: %w", chunkName(chunk), err)
+	}
+
+	c.Custom = append(c.Custom, custom)
+	c.Extras = append(c.Extras, custom)
+	return nil
+}
+
 func ParseWaveRIFF(riffChunk *riffbin.RIFFChunk, ignoreUnknownChunk bool) (fmtChunk FormatChunk, infoChunk *InfoChunk, factChunk *FactChunk, sampleReader riffbin.SubChunk, err error) {
-	if riffChunk.FormType != waveBytes {
-		err = fmt.Errorf("%w: %s", ErrUnexpectedFormType, string(riffChunk.FormType[:]))
+	var chunks *WaveChunks
+	chunks, err = ParseWaveChunks(riffChunk, ignoreUnknownChunk)
+	if err != nil {
 		return
 	}
 
+	return chunks.Format, chunks.Info, chunks.Fact, chunks.Data, nil
+}
+
+// ParseWaveChunks parses the WAVE RIFF chunk as well as ParseWaveRIFF, but it returns all of the known chunks.
+func ParseWaveChunks(riffChunk *riffbin.RIFFChunk, ignoreUnknownChunk bool) (*WaveChunks, error) {
+	return ParseWaveChunksWithOptions(riffChunk, ParseOptions{
+		IgnoreUnknownChunk:  ignoreUnknownChunk,
+		AllowDuplicateChunk: true,
+		AllowMissingFact:    true,
+	})
+}
+
+// ParseWaveChunksWithOptions parses the WAVE RIFF chunk as well as ParseWaveChunks with the options.
+func ParseWaveChunksWithOptions(riffChunk *riffbin.RIFFChunk, opts ParseOptions) (*WaveChunks, error) {
+	if riffChunk.FormType != waveBytes {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedFormType, string(riffChunk.FormType[:]))
+	}
+
+	var chunks WaveChunks
+	var err error
 	for _, chunk := range riffChunk.Payload {
-		if bytes.Equal(chunk.ChunkID(), junkBytes[:]) {
+		if bytes.Equal(chunk.ChunkID(), junkBytes[:]) || bytes.Equal(chunk.ChunkID(), upperJunkBytes[:]) {
+			if opts.KeepJunkChunk {
+				raw, err := newRawChunk(chunk)
+				if err != nil {
+					return nil, fmt.Errorf("RIFF[WAVE].%w", err)
+				}
+
+				chunks.Extras = append(chunks.Extras, raw)
+			}
 			continue
 		}
+		if bytes.Equal(chunk.ChunkID(), listBytes[:]) {
+			if _, ok := chunk.(*riffbin.ListChunk); !ok {
+				return nil, fmt.Errorf("RIFF[WAVE].LIST: %w", ErrUnexpectedChunkType)
+			}
+		}
 
-		if bytes.Equal(chunk.ChunkID(), fmtBytes[:]) {
-			fmtChunk, err = parseFormatChunk(chunk)
+		if decoder, ok := opts.lookupDecoder(chunk); ok {
+			err = chunks.appendCustom(chunk, decoder)
 			if err != nil {
-				return
+				return nil, err
 			}
-		} else if bytes.Equal(chunk.ChunkID(), dataBytes[:]) {
-			sampleReader, err = parseDataChunk(chunk)
+			continue
+		}
+
+		if bytes.Equal(chunk.ChunkID(), fmtBytes[:]) {
+			if chunks.Format != nil && !opts.AllowDuplicateChunk {
+				return nil, fmt.Errorf("RIFF[WAVE].fmt: %w", ErrDuplicateChunk)
+			}
+
+			chunks.Format, err = parseFormatChunk(chunk)
 			if err != nil {
-				return
+				return nil, err
 			}
-		} else if bytes.Equal(chunk.ChunkID(), listBytes[:]) {
-			listChunk, ok := chunk.(*riffbin.ListChunk)
-			if !ok {
-				err = fmt.Errorf("RIFF[WAVE].LIST: %w", ErrUnexpectedChunkType)
-				return
+		} else if bytes.Equal(chunk.ChunkID(), dataBytes[:]) {
+			if chunks.Data != nil && !opts.AllowDuplicateChunk {
+				return nil, fmt.Errorf("RIFF[WAVE].data: %w", ErrDuplicateChunk)
 			}
 
-			if listChunk.ListType == infoBytes {
-				infoChunk, err = parseInfoChunk(listChunk)
-				if err != nil {
-					return
-				}
-			} else {
-				// unknown chunk
-				if ignoreUnknownChunk {
-					continue
-				}
-
-				err = fmt.Errorf("RIFF[WAVE].LIST[%s]: %w", string(listChunk.ListType[:]), ErrUnknownListType)
-				return
+			chunks.Data, err = parseDataChunk(chunk)
+			if err != nil {
+				return nil, err
+			}
+		} else if parse, ok := lookupBuiltinChunkParser(chunk); ok {
+			provider, err := parse(&chunks, chunk, &opts)
+			if err != nil {
+				return nil, err
 			}
-		} else if bytes.Equal(chunk.ChunkID(), factBytes[:]) {
-			factChunk, err = parseFactChunk(chunk)
+			chunks.Extras = append(chunks.Extras, provider)
+		} else if decoder, ok := opts.lookupRegistry(chunk); ok {
+			err = chunks.appendCustom(chunk, decoder)
 			if err != nil {
-				return
+				return nil, err
 			}
 		} else {
 			// unknown chunk
-			if ignoreUnknownChunk {
+			if opts.IgnoreUnknownChunk {
+				err = chunks.appendUnknown(chunk)
+				if err != nil {
+					return nil, err
+				}
 				continue
 			}
 
-			err = fmt.Errorf("RIFF[WAVE].%s: %w", string(chunk.ChunkID()), ErrUnknownChunk)
-			return
+			if listChunk, ok := chunk.(*riffbin.ListChunk); ok {
+				return nil, fmt.Errorf("RIFF[WAVE].LIST[%s]: %w", string(listChunk.ListType[:]), ErrUnknownListType)
+			}
+			return nil, fmt.Errorf("RIFF[WAVE].%s: %w", string(chunk.ChunkID()), ErrUnknownChunk)
 		}
 	}
-	if fmtChunk == nil || sampleReader == nil {
-		err = ErrLackOfRequiredChunks
-		return
+	if chunks.Format == nil || chunks.Data == nil {
+		return nil, ErrLackOfRequiredChunks
+	}
+	if chunks.Fact == nil && !opts.AllowMissingFact && needsFactChunk(chunks.Format) {
+		return nil, fmt.Errorf("RIFF[WAVE].fact: %w", ErrLackOfRequiredChunks)
+	}
+	if chunks.Info != nil {
+		// CSET chunk can be after INFO list chunk
+		decode := opts.InfoTextDecoder
+		if decode == nil && chunks.Cset != nil {
+			decode, _ = chunks.Cset.TextDecoder()
+		}
+		if decode != nil {
+			for key, value := range chunks.Info.Data {
+				chunks.Info.Data[key] = decode([]byte(value))
+			}
+		}
+		if chunks.Cset != nil {
+			// keep the character set on writing
+			chunks.Info.CodePage = chunks.Cset.CodePage
+		}
 	}
 
-	return
+	return &chunks, nil
+}
+
+// builtinChunkParser parses the built-in chunk and sets it to WaveChunks.
+type builtinChunkParser func(chunks *WaveChunks, chunk riffbin.Chunk, opts *ParseOptions) (ChunkProvider, error)
+
+var builtinChunkParsers = map[[4]byte]builtinChunkParser{
+	factBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		factChunk, err := parseFactChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.Fact = factChunk
+		return factChunk, nil
+	},
+	bextBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		bextChunk, err := parseBextChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.Bext = bextChunk
+		return bextChunk, nil
+	},
+	cueBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		cueChunk, err := parseCueChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.Cue = cueChunk
+		return cueChunk, nil
+	},
+	smplBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		smplChunk, err := parseSamplerChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.Smpl = smplChunk
+		return smplChunk, nil
+	},
+	instBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		instChunk, err := parseInstrumentChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.Inst = instChunk
+		return instChunk, nil
+	},
+	csetBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		csetChunk, err := parseCharacterSetChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.Cset = csetChunk
+		return csetChunk, nil
+	},
+	ixmlBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		ixmlChunk, err := parseIXMLChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.IXML = ixmlChunk
+		return ixmlChunk, nil
+	},
+	cartBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		cartChunk, err := parseCartChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.Cart = cartChunk
+		return cartChunk, nil
+	},
+}
+
+var builtinListParsers = map[[4]byte]builtinChunkParser{
+	infoBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, _ *ParseOptions) (ChunkProvider, error) {
+		infoChunk, err := parseInfoChunk(chunk.(*riffbin.ListChunk))
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.Info = infoChunk
+		return infoChunk, nil
+	},
+	adtlBytes: func(chunks *WaveChunks, chunk riffbin.Chunk, opts *ParseOptions) (ChunkProvider, error) {
+		adtlChunk, err := parseAssociatedDataListChunk(chunk.(*riffbin.ListChunk), opts.IgnoreUnknownChunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks.AssociatedData = adtlChunk
+		return adtlChunk, nil
+	},
+}
+
+func lookupBuiltinChunkParser(chunk riffbin.Chunk) (builtinChunkParser, bool) {
+	if listChunk, ok := chunk.(*riffbin.ListChunk); ok {
+		parse, ok := builtinListParsers[listChunk.ListType]
+		return parse, ok
+	}
+
+	var id [4]byte
+	copy(id[:], chunk.ChunkID())
+	parse, ok := builtinChunkParsers[id]
+	return parse, ok
 }
 
 func parseFormatChunk(chunk riffbin.Chunk) (FormatChunk, error) {
@@ -138,6 +363,191 @@ func parseFactChunk(chunk riffbin.Chunk) (*FactChunk, error) {
 	return &FactChunk{SampleLength: SampleLength(binary.LittleEndian.Uint32(b[:]))}, nil
 }
 
+func parseBextChunk(chunk riffbin.Chunk) (*BextChunk, error) {
+	subChunk, ok := chunk.(riffbin.SubChunk)
+	if !ok {
+		return nil, fmt.Errorf("RIFF[WAVE].bext: %w", ErrUnexpectedChunkType)
+	}
+	if subChunk.BodySize() < bextFixedSize {
+		return nil, fmt.Errorf("RIFF[WAVE].bext: %w", ErrUnexpectedChunkSize)
+	}
+
+	bextChunk := &BextChunk{}
+	_, err := bextChunk.ReadFrom(subChunk)
+	if err != nil {
+		return nil, fmt.Errorf("RIFF[WAVE].bext: %w", err)
+	}
+
+	return bextChunk, nil
+}
+
+func parseCueChunk(chunk riffbin.Chunk) (*CueChunk, error) {
+	subChunk, ok := chunk.(riffbin.SubChunk)
+	if !ok {
+		return nil, fmt.Errorf("RIFF[WAVE].cue: %w", ErrUnexpectedChunkType)
+	}
+	if subChunk.BodySize() < 4 || (subChunk.BodySize()-4)%cuePointSize != 0 {
+		return nil, fmt.Errorf("RIFF[WAVE].cue: %w", ErrUnexpectedChunkSize)
+	}
+
+	var b bytes.Buffer
+	_, err := io.Copy(&b, subChunk)
+	if err != nil {
+		return nil, fmt.Errorf("RIFF[WAVE].cue: %w", err)
+	}
+	if count := binary.LittleEndian.Uint32(b.Bytes()[:4]); uint64(count) != uint64(b.Len()-4)/cuePointSize {
+		return nil, fmt.Errorf("RIFF[WAVE].cue: %w: %d cue points in %d bytes", ErrUnexpectedChunkSize, count, b.Len())
+	}
+
+	cueChunk := &CueChunk{}
+	_, err = cueChunk.ReadFrom(&b)
+	if err != nil {
+		return nil, fmt.Errorf("RIFF[WAVE].cue: %w", err)
+	}
+
+	return cueChunk, nil
+}
+
+func parseSamplerChunk(chunk riffbin.Chunk) (*SamplerChunk, error) {
+	subChunk, ok := chunk.(riffbin.SubChunk)
+	if !ok {
+		return nil, fmt.Errorf("RIFF[WAVE].smpl: %w", ErrUnexpectedChunkType)
+	}
+	if subChunk.BodySize() < smplFixedSize {
+		return nil, fmt.Errorf("RIFF[WAVE].smpl: %w", ErrUnexpectedChunkSize)
+	}
+
+	smplChunk := &SamplerChunk{}
+	_, err := smplChunk.ReadFrom(subChunk)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("RIFF[WAVE].smpl: %w: %v", ErrUnexpectedChunkSize, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("RIFF[WAVE].smpl: %w", err)
+	}
+
+	return smplChunk, nil
+}
+
+func parseInstrumentChunk(chunk riffbin.Chunk) (*InstrumentChunk, error) {
+	subChunk, ok := chunk.(riffbin.SubChunk)
+	if !ok {
+		return nil, fmt.Errorf("RIFF[WAVE].inst: %w", ErrUnexpectedChunkType)
+	}
+	if subChunk.BodySize() < instSize {
+		return nil, fmt.Errorf("RIFF[WAVE].inst: %w", ErrUnexpectedChunkSize)
+	}
+
+	instChunk := &InstrumentChunk{}
+	_, err := instChunk.ReadFrom(subChunk)
+	if err != nil {
+		return nil, fmt.Errorf("RIFF[WAVE].inst: %w", err)
+	}
+
+	return instChunk, nil
+}
+
+func parseCharacterSetChunk(chunk riffbin.Chunk) (*CharacterSetChunk, error) {
+	subChunk, ok := chunk.(riffbin.SubChunk)
+	if !ok {
+		return nil, fmt.Errorf("RIFF[WAVE].CSET: %w", ErrUnexpectedChunkType)
+	}
+	if subChunk.BodySize() < csetSize {
+		return nil, fmt.Errorf("RIFF[WAVE].CSET: %w", ErrUnexpectedChunkSize)
+	}
+
+	csetChunk := &CharacterSetChunk{}
+	_, err := csetChunk.ReadFrom(subChunk)
+	if err != nil {
+		return nil, fmt.Errorf("RIFF[WAVE].CSET: %w", err)
+	}
+
+	return csetChunk, nil
+}
+
+func parseIXMLChunk(chunk riffbin.Chunk) (*IXMLChunk, error) {
+	subChunk, ok := chunk.(riffbin.SubChunk)
+	if !ok {
+		return nil, fmt.Errorf("RIFF[WAVE].iXML: %w", ErrUnexpectedChunkType)
+	}
+
+	ixmlChunk := &IXMLChunk{}
+	_, err := ixmlChunk.ReadFrom(subChunk)
+	if err != nil {
+		return nil, fmt.Errorf("RIFF[WAVE].iXML: %w", err)
+	}
+
+	return ixmlChunk, nil
+}
+
+func parseCartChunk(chunk riffbin.Chunk) (*CartChunk, error) {
+	subChunk, ok := chunk.(riffbin.SubChunk)
+	if !ok {
+		return nil, fmt.Errorf("RIFF[WAVE].cart: %w", ErrUnexpectedChunkType)
+	}
+	if subChunk.BodySize() < cartFixedSize {
+		return nil, fmt.Errorf("RIFF[WAVE].cart: %w", ErrUnexpectedChunkSize)
+	}
+
+	cartChunk := &CartChunk{}
+	_, err := cartChunk.ReadFrom(subChunk)
+	if err != nil {
+		return nil, fmt.Errorf("RIFF[WAVE].cart: %w", err)
+	}
+
+	return cartChunk, nil
+}
+
+func parseAssociatedDataListChunk(listChunk *riffbin.ListChunk, ignoreUnknownChunk bool) (*AssociatedDataListChunk, error) {
+	adtlChunk := &AssociatedDataListChunk{}
+	for _, chunk := range listChunk.Payload {
+		subChunk, ok := chunk.(riffbin.SubChunk)
+		if !ok {
+			return nil, fmt.Errorf("RIFF[WAVE].adtl.%s: %w", string(chunk.ChunkID()), ErrUnexpectedChunkType)
+		}
+
+		if bytes.Equal(chunk.ChunkID(), lablBytes[:]) {
+			cuePointID, text, err := readCueText(subChunk)
+			if err != nil {
+				return nil, fmt.Errorf("RIFF[WAVE].adtl.labl: %w", err)
+			}
+
+			adtlChunk.Labels = append(adtlChunk.Labels, CueLabel{CuePointID: cuePointID, Text: text})
+		} else if bytes.Equal(chunk.ChunkID(), noteBytes[:]) {
+			cuePointID, text, err := readCueText(subChunk)
+			if err != nil {
+				return nil, fmt.Errorf("RIFF[WAVE].adtl.note: %w", err)
+			}
+
+			adtlChunk.Notes = append(adtlChunk.Notes, CueNote{CuePointID: cuePointID, Text: text})
+		} else if bytes.Equal(chunk.ChunkID(), ltxtBytes[:]) {
+			labeledText, err := readCueLabeledText(subChunk)
+			if err != nil {
+				return nil, fmt.Errorf("RIFF[WAVE].adtl.ltxt: %w", err)
+			}
+
+			adtlChunk.LabeledTexts = append(adtlChunk.LabeledTexts, labeledText)
+		} else {
+			// unknown chunk
+			if ignoreUnknownChunk {
+				raw, err := newRawChunk(subChunk)
+				if err != nil {
+					return nil, fmt.Errorf("RIFF[WAVE].adtl.%w", err)
+				}
+
+				adtlChunk.Unknown = append(adtlChunk.Unknown, raw)
+			} else {
+				return nil, fmt.Errorf("RIFF[WAVE].adtl.%s: %w", string(chunk.ChunkID()), ErrUnknownChunk)
+			}
+		}
+
+		var id [4]byte
+		copy(id[:], chunk.ChunkID())
+		adtlChunk.Order = append(adtlChunk.Order, id)
+	}
+
+	return adtlChunk, nil
+}
+
 func parseInfoChunk(listChunk *riffbin.ListChunk) (*InfoChunk, error) {
 	infoChunk := &InfoChunk{Data: map[InfoKey]string{}}
 	for _, chunk := range listChunk.Payload {
@@ -152,9 +562,10 @@ func parseInfoChunk(listChunk *riffbin.ListChunk) (*InfoChunk, error) {
 			return nil, fmt.Errorf("RIFF[WAVE].INFO.%s: %w", string(chunk.ChunkID()), err)
 		}
 
+		// strip the NUL terminator and the padding
 		var key InfoKey
 		copy(key[:], subChunk.ChunkID())
-		infoChunk.Data[key] = s.String()
+		infoChunk.Data[key] = strings.TrimRight(s.String(), "\x00")
 	}
 
 	return infoChunk, nil
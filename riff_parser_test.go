@@ -1,6 +1,7 @@
 package wavebin_test
 
 import (
+	"errors"
 	"io"
 	"testing"
 
@@ -535,3 +536,51 @@ func TestParseWaveRIFF(t *testing.T) {
 		})
 	}
 }
+
+func TestParseWaveChunks(t *testing.T) {
+	bextChunk := &wavebin.BextChunk{
+		Description:   "description",
+		Originator:    "wavebin",
+		Version:       2,
+		LoudnessValue: -2300,
+		CodingHistory: "A=PCM,F=44100,W=8,M=mono\r\n",
+	}
+	riffChunk := wavebin.CreateCompletedRIFF(
+		&wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		},
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		bextChunk,
+	)
+
+	chunks, err := wavebin.ParseWaveChunks(riffChunk, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if df := cmp.Diff(bextChunk, chunks.Bext); df != "" {
+		t.Errorf("bext diff: %s", df)
+	}
+
+	samples, err := io.ReadAll(chunks.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if df := cmp.Diff([]byte{0x00, 0x00, 0x00, 0x00}, samples); df != "" {
+		t.Errorf("samples diff: %s", df)
+	}
+
+	t.Run("InvalidBextChunk", func(t *testing.T) {
+		_, err := wavebin.ParseWaveChunks(&riffbin.RIFFChunk{
+			FormType: [4]byte{'W', 'A', 'V', 'E'},
+			Payload: []riffbin.Chunk{
+				&riffbin.OnMemorySubChunk{
+					ID:      [4]byte{'b', 'e', 'x', 't'},
+					Payload: []byte{0x00},
+				},
+			},
+		}, false)
+		if !errors.Is(err, wavebin.ErrUnexpectedChunkSize) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+}
@@ -0,0 +1,281 @@
+package wavebin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// ReadWaveRIFF reads RIFF, RF64 or BW64 WAVE binary from r, and returns it as *riffbin.RIFFChunk to be passed to ParseWaveRIFF.
+// The 64bit sizes in the ds64 chunk are resolved and the ds64 chunk itself is not included in the result.
+// The pad byte after the odd-sized chunk is skipped, and the chunks written without the pad byte are also accepted.
+// If r implements riffbin.PartialReader (e.g. *os.File), the sub-chunks are *riffbin.InStreamSubChunk to be read lazily,
+// otherwise the sub-chunks are *riffbin.OnMemorySubChunk.
+// The data chunk of the 0xFFFFFFFF placeholder size or the size exceeding the RIFF chunk is read until EOF as written by CreateStreamingSampleWriter.
+// In such a case, the data chunk is *riffbin.IncompleteSubChunk to read r if r does not implement riffbin.PartialReader.
+func ReadWaveRIFF(r io.Reader) (*riffbin.RIFFChunk, error) {
+	riffChunk, _, err := readWaveRIFF(r)
+	return riffChunk, err
+}
+
+// readWaveRIFF reads the WAVE binary as well as ReadWaveRIFF, and returns the paths of the odd-sized chunks without the pad byte before the next chunk.
+func readWaveRIFF(r io.Reader) (*riffbin.RIFFChunk, []string, error) {
+	cr := &chunkReader{r: r}
+	if pr, ok := r.(riffbin.PartialReader); ok {
+		pos, err := pr.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, nil, fmt.Errorf("seek: %w", err)
+		}
+
+		cr.pr = pr
+		cr.pos = pos
+	}
+
+	id, size, err := cr.readHeader()
+	if err != nil {
+		return nil, nil, invalidFormatError(err)
+	}
+
+	var formType [4]byte
+	if err := cr.readFull(formType[:]); err != nil {
+		return nil, nil, invalidFormatError(err)
+	}
+	if formType != waveBytes {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnexpectedFormType, string(formType[:]))
+	}
+
+	remaining := uint64(size)
+	switch id {
+	case riffBytes:
+		cr.untilEOF = size == rf64PlaceholderSize
+	case rf64Bytes, bw64Bytes:
+		ds64ID, ds64Size, err := cr.readHeader()
+		if err != nil {
+			return nil, nil, invalidFormatError(err)
+		}
+		if ds64ID != ds64Bytes || ds64Size < ds64BodySize {
+			return nil, nil, fmt.Errorf("%s[WAVE].ds64: %w", string(id[:]), ErrLackOfRequiredChunks)
+		}
+		if ds64Size > maxDS64Size {
+			return nil, nil, fmt.Errorf("%s[WAVE].ds64: %w: %d bytes", string(id[:]), ErrUnexpectedChunkSize, ds64Size)
+		}
+
+		body := make([]byte, ds64Size)
+		if err := cr.readFull(body); err != nil {
+			return nil, nil, invalidFormatError(err)
+		}
+
+		cr.ds64 = &DataSize64Chunk{}
+		if _, err := cr.ds64.ReadFrom(bytes.NewReader(body)); err != nil {
+			return nil, nil, fmt.Errorf("%s[WAVE].ds64: %w", string(id[:]), invalidFormatError(err))
+		}
+
+		remaining = cr.ds64.RIFFSize
+		if size != rf64PlaceholderSize {
+			remaining = uint64(size)
+		}
+		if remaining < 4+riffbin.HeaderBytes+uint64(ds64Size) {
+			return nil, nil, fmt.Errorf("%s: %w", string(id[:]), ErrUnexpectedChunkSize)
+		}
+		remaining -= riffbin.HeaderBytes + uint64(ds64Size)
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", riffbin.ErrInvalidFormat, string(id[:]))
+	}
+	if remaining < 4 {
+		return nil, nil, fmt.Errorf("%s: %w", string(id[:]), ErrUnexpectedChunkSize)
+	}
+
+	payload, err := cr.readChunks("RIFF[WAVE]", remaining-4)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &riffbin.RIFFChunk{
+		FormType: waveBytes,
+		Payload:  payload,
+	}, cr.unpadded, nil
+}
+
+type chunkReader struct {
+	r    io.Reader
+	pr   riffbin.PartialReader
+	pos  int64
+	ds64 *DataSize64Chunk
+
+	// untilEOF is true if the RIFF chunk size is unknown, then the chunks are read until EOF.
+	untilEOF bool
+
+	// peeked is the byte read by skipPadByte that is not a pad byte.
+	peeked []byte
+
+	// unpadded is the paths of the odd-sized chunks without the pad byte.
+	unpadded []string
+}
+
+func (cr *chunkReader) readFull(b []byte) error {
+	// the peeked byte is already counted in pos
+	peeked := copy(b, cr.peeked)
+	cr.peeked = cr.peeked[peeked:]
+
+	n, err := io.ReadFull(cr.r, b[peeked:])
+	cr.pos += int64(n)
+	if err == io.EOF && peeked != 0 {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// skipPadByte skips the pad byte after the odd-sized chunk body, and returns true if it's skipped.
+// Some writers (including riffbin.CompletedChunkWriter) omit the pad byte, so it's skipped only if it's NUL
+// because the next chunk ID cannot start with NUL. Otherwise, the read byte is kept to be read as the next chunk header.
+func (cr *chunkReader) skipPadByte() (bool, error) {
+	var b [1]byte
+	if err := cr.readFull(b[:]); err != nil {
+		return false, err
+	}
+	if b[0] != 0 {
+		cr.peeked = b[:]
+		return false, nil
+	}
+	return true, nil
+}
+
+func (cr *chunkReader) readHeader() (id [4]byte, size uint32, err error) {
+	var b [riffbin.HeaderBytes]byte
+	if err = cr.readFull(b[:]); err != nil {
+		return
+	}
+
+	copy(id[:], b[:4])
+	size = binary.LittleEndian.Uint32(b[4:])
+	return
+}
+
+func (cr *chunkReader) readChunks(parent string, remaining uint64) ([]riffbin.Chunk, error) {
+	var payload []riffbin.Chunk
+	for remaining > 0 {
+		if remaining < riffbin.HeaderBytes {
+			return nil, fmt.Errorf("%w: broken chunk header", riffbin.ErrInvalidFormat)
+		}
+
+		id, size32, err := cr.readHeader()
+		if err == io.EOF && cr.untilEOF {
+			break
+		} else if err != nil {
+			return nil, invalidFormatError(err)
+		}
+		remaining -= riffbin.HeaderBytes
+
+		size := uint64(size32)
+		if size32 == rf64PlaceholderSize && cr.ds64 != nil {
+			var ok bool
+			size, ok = cr.ds64.chunkSize(id)
+			if !ok {
+				return nil, fmt.Errorf("%s: %w: no size in ds64", string(id[:]), ErrUnexpectedChunkSize)
+			}
+		}
+		if id == dataBytes && ((size32 == rf64PlaceholderSize && cr.ds64 == nil) || size > remaining) {
+			// the data chunk of the unknown size is the last chunk
+			chunk, err := cr.readChunkUntilEOF(id)
+			if err != nil {
+				return nil, err
+			}
+
+			return append(payload, chunk), nil
+		}
+		if size > remaining {
+			return nil, fmt.Errorf("%s: %w: %d bytes exceeds the parent chunk", string(id[:]), ErrUnexpectedChunkSize, size)
+		}
+		remaining -= size
+
+		chunk, err := cr.readChunk(parent, id, size)
+		if err != nil {
+			return nil, err
+		}
+
+		payload = append(payload, chunk)
+
+		if size%2 != 0 && remaining > 0 {
+			skipped, err := cr.skipPadByte()
+			if err == io.EOF && cr.untilEOF {
+				break
+			} else if err != nil {
+				return nil, invalidFormatError(err)
+			}
+			if skipped {
+				remaining--
+			} else {
+				cr.unpadded = append(cr.unpadded, parent+"."+chunkName(chunk))
+			}
+		}
+	}
+
+	return payload, nil
+}
+
+func (cr *chunkReader) readChunk(parent string, id [4]byte, size uint64) (riffbin.Chunk, error) {
+	if id == listBytes {
+		if size < 4 {
+			return nil, fmt.Errorf("LIST: %w", ErrUnexpectedChunkSize)
+		}
+
+		var listType [4]byte
+		if err := cr.readFull(listType[:]); err != nil {
+			return nil, invalidFormatError(err)
+		}
+
+		payload, err := cr.readChunks(parent+".LIST["+string(listType[:])+"]", size-4)
+		if err != nil {
+			return nil, fmt.Errorf("LIST[%s]: %w", string(listType[:]), err)
+		}
+		if payload == nil {
+			payload = []riffbin.Chunk{}
+		}
+
+		return &riffbin.ListChunk{ListType: listType, Payload: payload}, nil
+	}
+
+	if cr.pr != nil {
+		// skip sub-chunk body
+		if _, err := cr.pr.Seek(int64(size), io.SeekCurrent); err != nil {
+			return nil, fmt.Errorf("seek: %w", err)
+		}
+
+		chunk := &riffbin.InStreamSubChunk{ID: id, SectionReader: io.NewSectionReader(cr.pr, cr.pos, int64(size))}
+		cr.pos += int64(size)
+		return chunk, nil
+	}
+
+	chunk := &riffbin.OnMemorySubChunk{ID: id, Payload: make([]byte, size)}
+	if err := cr.readFull(chunk.Payload); err != nil {
+		return nil, invalidFormatError(err)
+	}
+	return chunk, nil
+}
+
+// readChunkUntilEOF reads the sub-chunk of the unknown size until EOF.
+func (cr *chunkReader) readChunkUntilEOF(id [4]byte) (riffbin.Chunk, error) {
+	if cr.pr == nil {
+		return riffbin.NewIncompleteSubChunk(id, cr.r), nil
+	}
+
+	end, err := cr.pr.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("seek: %w", err)
+	}
+
+	chunk := &riffbin.InStreamSubChunk{ID: id, SectionReader: io.NewSectionReader(cr.pr, cr.pos, end-cr.pos)}
+	cr.pos = end
+	return chunk, nil
+}
+
+func invalidFormatError(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: %v", riffbin.ErrInvalidFormat, err)
+	}
+	return err
+}
@@ -0,0 +1,174 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestReadWaveRIFF(t *testing.T) {
+	t.Run("RIFF", func(t *testing.T) {
+		newRIFF := func() *riffbin.RIFFChunk {
+			return wavebin.CreateCompletedRIFF(
+				&wavebin.ExtendedFormatChunk{
+					MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+				},
+				[]byte{0x00, 0x01, 0x02},
+				&wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{
+					wavebin.InfoArtistIART: "AAA",
+				}},
+			)
+		}
+
+		var buf bytes.Buffer
+		_, err := riffbin.NewCompletedChunkWriter(&buf).Write(newRIFF())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := wavebin.ReadWaveRIFF(io.MultiReader(&buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if df := cmp.Diff(newRIFF(), got, cmpopts.IgnoreUnexported(riffbin.OnMemorySubChunk{})); df != "" {
+			t.Errorf("RIFF chunk diff: %s", df)
+		}
+	})
+
+	t.Run("PadByte", func(t *testing.T) {
+		format := &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		}
+		extras := []wavebin.ChunkProvider{
+			&wavebin.RawChunk{ID: [4]byte{'o', 'd', 'd', ' '}, Payload: []byte{0x01, 0x02, 0x03}},
+			&wavebin.RawListChunk{
+				ListType: [4]byte{'v', 'n', 'd', 'r'},
+				Payload: []wavebin.ChunkProvider{
+					&wavebin.RawChunk{ID: [4]byte{'a', 'b', 'c', 'd'}, Payload: []byte{0x01}},
+					&wavebin.RawChunk{ID: [4]byte{'e', 'f', 'g', 'h'}, Payload: []byte{0x01, 0x02}},
+				},
+			},
+		}
+		samples := []byte{0x00, 0x01, 0x02}
+
+		var buf bytes.Buffer
+		w, err := wavebin.CreateStreamingSampleWriter(&buf, int64(len(samples)), format, extras...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(samples); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		b := buf.Bytes()
+		if expected := []byte("odd \x03\x00\x00\x00\x01\x02\x03\x00LIST\x18\x00\x00\x00vndrabcd\x01\x00\x00\x00\x01\x00efgh"); !bytes.Contains(b, expected) {
+			t.Errorf("no pad bytes: %q", b)
+		}
+		if size := binary.LittleEndian.Uint32(b[4:8]); int(size) != len(b)-riffbin.HeaderBytes {
+			t.Errorf("unexpected RIFF size: %d for %d bytes", size, len(b))
+		}
+
+		for _, r := range []io.Reader{bytes.NewReader(b), io.MultiReader(bytes.NewReader(b))} {
+			riffChunk, err := wavebin.ReadWaveRIFF(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			chunks, err := wavebin.ParseWaveChunks(riffChunk, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if df := cmp.Diff(extras, chunks.Extras, readSectionChunk); df != "" {
+				t.Errorf("extras diff: %s", df)
+			}
+
+			got, err := io.ReadAll(chunks.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if df := cmp.Diff(samples, got); df != "" {
+				t.Errorf("samples diff: %s", df)
+			}
+		}
+	})
+
+	t.Run("OddInstChunk", func(t *testing.T) {
+		// 7 bytes inst chunk with the pad byte before the data chunk
+		b := []byte("RIFF\x35\x00\x00\x00WAVE" +
+			"fmt \x10\x00\x00\x00\x01\x00\x01\x00\x44\xac\x00\x00\x44\xac\x00\x00\x01\x00\x08\x00" +
+			"inst\x07\x00\x00\x00\x3c\x00\x00\x00\x7f\x01\x7f\x00" +
+			"data\x01\x00\x00\x00\x80")
+
+		decoder, err := wavebin.NewDecoder(bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoder.Chunks().Inst == nil || decoder.Chunks().Inst.UnshiftedNote != 0x3c {
+			t.Errorf("unexpected inst chunk: %+v", decoder.Chunks().Inst)
+		}
+	})
+
+	for _, tt := range []struct {
+		name string
+		b    []byte
+		err  error
+	}{
+		{"Empty", []byte{}, riffbin.ErrInvalidFormat},
+		{"UnknownID", []byte("RIFX\x04\x00\x00\x00WAVE"), riffbin.ErrInvalidFormat},
+		{"UnknownFormType", []byte("RIFF\x04\x00\x00\x00AVI "), wavebin.ErrUnexpectedFormType},
+		{"TooLargeSubChunk", []byte("RIFF\x0c\x00\x00\x00WAVEfact\x10\x00\x00\x00"), wavebin.ErrUnexpectedChunkSize},
+		{"TruncatedSubChunk", []byte("RIFF\x10\x00\x00\x00WAVEdata\x04\x00\x00\x00"), riffbin.ErrInvalidFormat},
+		{"NoDS64", []byte("RF64\xff\xff\xff\xffWAVEdata\x00\x00\x00\x00"), wavebin.ErrLackOfRequiredChunks},
+		{"TooLargeDS64", []byte("RF64\xff\xff\xff\xffWAVEds64\xff\xff\xff\xff"), wavebin.ErrUnexpectedChunkSize},
+		{"TooManyDS64Entries", append([]byte("RF64\xff\xff\xff\xffWAVEds64\x1c\x00\x00\x00"), append(make([]byte, 24), 0xff, 0xff, 0xff, 0xff)...), wavebin.ErrUnexpectedChunkSize},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := wavebin.ReadWaveRIFF(io.MultiReader(bytes.NewReader(tt.b)))
+			if !errors.Is(err, tt.err) {
+				t.Errorf("unexpected err: %v", err)
+			}
+		})
+	}
+
+	t.Run("DataUntilEOF", func(t *testing.T) {
+		for _, tt := range []struct {
+			name string
+			b    []byte
+		}{
+			{"Placeholder", []byte("RIFF\xff\xff\xff\xffWAVEdata\xff\xff\xff\xff\x00\x01\x02")},
+			{"ExceedsRIFF", []byte("RIFF\x10\x00\x00\x00WAVEdata\x10\x00\x00\x00\x00\x01\x02")},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				for _, r := range []io.Reader{bytes.NewReader(tt.b), io.MultiReader(bytes.NewReader(tt.b))} {
+					got, err := wavebin.ReadWaveRIFF(r)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if len(got.Payload) != 1 {
+						t.Fatalf("unexpected chunks: %d", len(got.Payload))
+					}
+
+					samples, err := io.ReadAll(got.Payload[0].(riffbin.SubChunk))
+					if err != nil {
+						t.Fatal(err)
+					}
+					if df := cmp.Diff([]byte{0x00, 0x01, 0x02}, samples); df != "" {
+						t.Errorf("samples diff: %s", df)
+					}
+				}
+			})
+		}
+	})
+}
@@ -7,13 +7,29 @@ import (
 )
 
 var (
-	waveBytes = [4]byte{'W', 'A', 'V', 'E'}
-	listBytes = [4]byte{'L', 'I', 'S', 'T'}
-	fmtBytes  = [4]byte{'f', 'm', 't', ' '}
-	factBytes = [4]byte{'f', 'a', 'c', 't'}
-	infoBytes = [4]byte{'I', 'N', 'F', 'O'}
-	dataBytes = [4]byte{'d', 'a', 't', 'a'}
-	junkBytes = [4]byte{'j', 'u', 'n', 'k'}
+	riffBytes      = [4]byte{'R', 'I', 'F', 'F'}
+	rf64Bytes      = [4]byte{'R', 'F', '6', '4'}
+	bw64Bytes      = [4]byte{'B', 'W', '6', '4'}
+	waveBytes      = [4]byte{'W', 'A', 'V', 'E'}
+	listBytes      = [4]byte{'L', 'I', 'S', 'T'}
+	fmtBytes       = [4]byte{'f', 'm', 't', ' '}
+	factBytes      = [4]byte{'f', 'a', 'c', 't'}
+	infoBytes      = [4]byte{'I', 'N', 'F', 'O'}
+	dataBytes      = [4]byte{'d', 'a', 't', 'a'}
+	junkBytes      = [4]byte{'j', 'u', 'n', 'k'}
+	upperJunkBytes = [4]byte{'J', 'U', 'N', 'K'}
+	ds64Bytes      = [4]byte{'d', 's', '6', '4'}
+	bextBytes      = [4]byte{'b', 'e', 'x', 't'}
+	cueBytes       = [4]byte{'c', 'u', 'e', ' '}
+	adtlBytes      = [4]byte{'a', 'd', 't', 'l'}
+	lablBytes      = [4]byte{'l', 'a', 'b', 'l'}
+	noteBytes      = [4]byte{'n', 'o', 't', 'e'}
+	ltxtBytes      = [4]byte{'l', 't', 'x', 't'}
+	smplBytes      = [4]byte{'s', 'm', 'p', 'l'}
+	instBytes      = [4]byte{'i', 'n', 's', 't'}
+	csetBytes      = [4]byte{'C', 'S', 'E', 'T'}
+	ixmlBytes      = [4]byte{'i', 'X', 'M', 'L'}
+	cartBytes      = [4]byte{'c', 'a', 'r', 't'}
 )
 
 type ChunkProvider interface {
@@ -1,42 +1,135 @@
 package wavebin
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/karupanerura/riffbin"
 )
 
+// CreateSampleWriter creates io.WriteCloser to write samples. The RIFF header is finalized on Close.
+// It returns ErrDataTooLarge on Write if the RIFF chunk exceeds 4GiB. Use CreateRF64SampleWriter for such large samples.
 func CreateSampleWriter(w io.WriteSeeker, format FormatChunk, extras ...ChunkProvider) (io.WriteCloser, error) {
-	cw, err := riffbin.NewIncompleteChunkWriter(w)
+	head, dataHeader, err := writeWaveHeader(w, 0, format, extras...)
 	if err != nil {
 		return nil, err
 	}
 
-	pr, pw := io.Pipe()
-	dataChunk := riffbin.NewIncompleteSubChunk(dataBytes, pr)
-	riffChunk := createRIFF(format, dataChunk, extras...)
+	return &sampleWriter{
+		w:          w,
+		head:       head,
+		dataHeader: dataHeader,
+		limit:      maxRIFFSize - uint64(dataHeader-head),
+	}, nil
+}
+
+// writeWaveHeader writes the RIFF header, the chunks and the data chunk header, and returns the positions of the RIFF header and the data chunk header.
+// The sizes in the headers are zero to be fixed after writing samples. If junkSize is not zero, a JUNK chunk is written to reserve the space before the fmt chunk.
+func writeWaveHeader(w io.WriteSeeker, junkSize uint32, format FormatChunk, extras ...ChunkProvider) (head, dataHeader int64, err error) {
+	head, err = w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		err = fmt.Errorf("seek: %w", err)
+		return
+	}
 
-	errCh := make(chan error, 1)
-	go func() {
-		_, err := cw.Write(riffChunk)
-		_ = pr.CloseWithError(err) // always be nil
-		errCh <- err
-		close(errCh)
-	}()
+	n, err := writeWaveHeaderChunks(w, 0, 0, waveHeaderChunks(junkSize, format, extras...))
+	if err != nil {
+		return
+	}
 
-	return &pipeWriter{pw: pw, errCh: errCh}, nil
+	dataHeader = head + n - riffbin.HeaderBytes
+	return
 }
 
-type pipeWriter struct {
-	pw    *io.PipeWriter
-	errCh chan error
+// waveHeaderChunks returns the chunks to be written before the data chunk.
+func waveHeaderChunks(junkSize uint32, format FormatChunk, extras ...ChunkProvider) []riffbin.Chunk {
+	chunks := make([]riffbin.Chunk, 0, 2+len(extras))
+	if junkSize != 0 {
+		chunks = append(chunks, &riffbin.OnMemorySubChunk{ID: upperJunkBytes, Payload: make([]byte, junkSize)})
+	}
+	chunks = append(chunks, format.Chunk())
+	for _, extra := range extras {
+		chunks = append(chunks, extra.Chunk())
+	}
+	return chunks
 }
 
-func (w *pipeWriter) Write(data []byte) (int, error) {
-	return w.pw.Write(data)
+// waveHeaderSize returns the size of the RIFF chunk body before the data chunk body.
+func waveHeaderSize(chunks []riffbin.Chunk) uint64 {
+	size := uint64(4 + riffbin.HeaderBytes) // form type and data chunk header
+	for _, chunk := range chunks {
+		size += riffbin.HeaderBytes + uint64(paddedSize(chunkBodySize(chunk)))
+	}
+	return size
 }
 
-func (w *pipeWriter) Close() error {
-	_ = w.pw.Close() // always be nil
-	return <-w.errCh
+// writeWaveHeaderChunks writes the RIFF header with riffSize, the chunks and the data chunk header with dataSize.
+func writeWaveHeaderChunks(w io.Writer, riffSize, dataSize uint32, chunks []riffbin.Chunk) (int64, error) {
+	// RIFF header and form type
+	n, err := writeChunkHeader(w, riffBytes[:], riffSize)
+	if err != nil {
+		return n, err
+	}
+	nn, err := w.Write(waveBytes[:])
+	n += int64(nn)
+	if err != nil {
+		return n, fmt.Errorf("RIFF[WAVE] type: %w", err)
+	}
+
+	for _, chunk := range chunks {
+		nnn, err := writeChunk(w, chunk)
+		n += nnn
+		if err != nil {
+			return n, fmt.Errorf("RIFF[WAVE]: %w", err)
+		}
+	}
+
+	nnn, err := writeChunkHeader(w, dataBytes[:], dataSize)
+	n += nnn
+	return n, err
+}
+
+// finalizeWaveSizes writes the sizes of the RIFF chunk and the data chunk written by writeWaveHeader.
+func finalizeWaveSizes(w io.WriteSeeker, head, dataHeader int64, dataSize uint32) error {
+	// RIFF chunk body is from the form type to the end of data chunk
+	riffSize := uint32(dataHeader-head) + dataSize
+	if err := writeUint32At(w, head+4, riffSize); err != nil {
+		return fmt.Errorf("RIFF: %w", err)
+	}
+	if err := writeUint32At(w, dataHeader+4, dataSize); err != nil {
+		return fmt.Errorf("RIFF[WAVE].data: %w", err)
+	}
+	return nil
+}
+
+type sampleWriter struct {
+	w          io.WriteSeeker
+	head       int64
+	dataHeader int64
+	limit      uint64
+	written    uint64
+	closed     bool
+}
+
+func (w *sampleWriter) Write(data []byte) (int, error) {
+	if w.closed {
+		// compatible with the former io.Pipe based implementation
+		return 0, io.ErrClosedPipe
+	}
+	if w.written+uint64(len(data)) > w.limit {
+		return 0, fmt.Errorf("%w: RIFF chunk exceeds %d bytes", ErrDataTooLarge, maxRIFFSize)
+	}
+
+	n, err := w.w.Write(data)
+	w.written += uint64(n)
+	return n, err
+}
+
+func (w *sampleWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	return finalizeWaveSizes(w.w, w.head, w.dataHeader, uint32(w.written))
 }
@@ -0,0 +1,94 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+// writeSeekerOnly hides io.WriterAt of the underlying writer.
+type writeSeekerOnly struct {
+	io.WriteSeeker
+}
+
+func TestCreateSampleWriter(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+	}
+	infoChunk := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoArtistIART: "AAA"}}
+	samples := []byte{0x00, 0x80, 0xff, 0x7f, 0x00, 0x40, 0x00, 0x00}
+	prefix := []byte("prefix")
+
+	var expected bytes.Buffer
+	_, err := riffbin.NewCompletedChunkWriter(&expected).Write(wavebin.CreateCompletedRIFF(format, samples, infoChunk))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		wrap func(f *os.File) io.WriteSeeker
+	}{
+		{"WriterAt", func(f *os.File) io.WriteSeeker { return f }},
+		{"WriteSeeker", func(f *os.File) io.WriteSeeker { return writeSeekerOnly{f} }},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.CreateTemp("", "wavebin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			defer f.Close()
+
+			_, err = f.Write(prefix)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w, err := wavebin.CreateSampleWriter(tt.wrap(f), format, infoChunk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < len(samples); i += 4 {
+				_, err = w.Write(samples[i : i+4])
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			err = w.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pos, err := f.Seek(0, io.SeekCurrent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pos != int64(len(prefix)+expected.Len()) {
+				t.Errorf("unexpected position after Close: %d", pos)
+			}
+
+			got, err := os.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(append(prefix, expected.Bytes()...), got) {
+				t.Errorf("unexpected bytes:\n%x\n%x", expected.Bytes(), got)
+			}
+
+			_, err = w.Write(samples)
+			if err == nil {
+				t.Error("should be error after Close")
+			}
+			err = w.Close()
+			if err != nil {
+				t.Errorf("unexpected err on second Close: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package wavebin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+const (
+	// smplFixedSize is the size of the smpl chunk body without the loops and the sampler data.
+	smplFixedSize = 36
+
+	// sampleLoopSize is the size of a sample loop in the smpl chunk.
+	sampleLoopSize = 24
+)
+
+type SampleLoopType uint32
+
+const (
+	SampleLoopForward     SampleLoopType = 0
+	SampleLoopAlternating SampleLoopType = 1
+	SampleLoopBackward    SampleLoopType = 2
+)
+
+// SampleLoop is a loop in the samples. Start and End are the sample positions and both of them are played.
+type SampleLoop struct {
+	CuePointID uint32
+	Type       SampleLoopType
+	Start      uint32
+	End        uint32
+	Fraction   uint32 // fraction of a sample at the loop point
+	PlayCount  uint32 // 0 means the infinite loop
+}
+
+// SamplerChunk is a smpl chunk to use the samples for the sampler.
+type SamplerChunk struct {
+	Manufacturer      uint32 // MIDI manufacturer code
+	Product           uint32
+	SamplePeriod      uint32 // nanoseconds per sample
+	MIDIUnityNote     uint32 // MIDI note number to play the samples as is
+	MIDIPitchFraction uint32 // fraction of a semitone above the unity note
+	SMPTEFormat       uint32 // 0, 24, 25, 29 or 30
+	SMPTEOffset       uint32 // 0xhhmmssff
+	Loops             []SampleLoop
+	SamplerData       []byte // manufacturer specific data
+}
+
+func (c *SamplerChunk) Bytes() (b []byte) {
+	b = make([]byte, smplFixedSize+sampleLoopSize*len(c.Loops)+len(c.SamplerData))
+
+	binary.LittleEndian.PutUint32(b[0:4], c.Manufacturer)
+	binary.LittleEndian.PutUint32(b[4:8], c.Product)
+	binary.LittleEndian.PutUint32(b[8:12], c.SamplePeriod)
+	binary.LittleEndian.PutUint32(b[12:16], c.MIDIUnityNote)
+	binary.LittleEndian.PutUint32(b[16:20], c.MIDIPitchFraction)
+	binary.LittleEndian.PutUint32(b[20:24], c.SMPTEFormat)
+	binary.LittleEndian.PutUint32(b[24:28], c.SMPTEOffset)
+	binary.LittleEndian.PutUint32(b[28:32], uint32(len(c.Loops)))
+	binary.LittleEndian.PutUint32(b[32:36], uint32(len(c.SamplerData)))
+	for i, l := range c.Loops {
+		lb := b[smplFixedSize+sampleLoopSize*i : smplFixedSize+sampleLoopSize*(i+1)]
+		binary.LittleEndian.PutUint32(lb[0:4], l.CuePointID)
+		binary.LittleEndian.PutUint32(lb[4:8], uint32(l.Type))
+		binary.LittleEndian.PutUint32(lb[8:12], l.Start)
+		binary.LittleEndian.PutUint32(lb[12:16], l.End)
+		binary.LittleEndian.PutUint32(lb[16:20], l.Fraction)
+		binary.LittleEndian.PutUint32(lb[20:24], l.PlayCount)
+	}
+	copy(b[smplFixedSize+sampleLoopSize*len(c.Loops):], c.SamplerData)
+
+	return
+}
+
+func (c *SamplerChunk) Chunk() riffbin.Chunk {
+	return &riffbin.OnMemorySubChunk{
+		ID:      smplBytes,
+		Payload: c.Bytes(),
+	}
+}
+
+func (c *SamplerChunk) ReadFrom(r io.Reader) (int64, error) {
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, r)
+	if err != nil {
+		return n, err
+	}
+
+	b := buf.Bytes()
+	if len(b) < smplFixedSize {
+		return n, io.ErrUnexpectedEOF
+	}
+
+	loops := binary.LittleEndian.Uint32(b[28:32])
+	samplerDataSize := binary.LittleEndian.Uint32(b[32:36])
+	if uint64(len(b)) < smplFixedSize+sampleLoopSize*uint64(loops)+uint64(samplerDataSize) {
+		return n, fmt.Errorf("%w: %d loops and %d bytes sampler data in %d bytes", io.ErrUnexpectedEOF, loops, samplerDataSize, len(b))
+	}
+
+	*c = SamplerChunk{
+		Manufacturer:      binary.LittleEndian.Uint32(b[0:4]),
+		Product:           binary.LittleEndian.Uint32(b[4:8]),
+		SamplePeriod:      binary.LittleEndian.Uint32(b[8:12]),
+		MIDIUnityNote:     binary.LittleEndian.Uint32(b[12:16]),
+		MIDIPitchFraction: binary.LittleEndian.Uint32(b[16:20]),
+		SMPTEFormat:       binary.LittleEndian.Uint32(b[20:24]),
+		SMPTEOffset:       binary.LittleEndian.Uint32(b[24:28]),
+		Loops:             make([]SampleLoop, loops),
+	}
+	for i := range c.Loops {
+		lb := b[smplFixedSize+sampleLoopSize*i : smplFixedSize+sampleLoopSize*(i+1)]
+		c.Loops[i] = SampleLoop{
+			CuePointID: binary.LittleEndian.Uint32(lb[0:4]),
+			Type:       SampleLoopType(binary.LittleEndian.Uint32(lb[4:8])),
+			Start:      binary.LittleEndian.Uint32(lb[8:12]),
+			End:        binary.LittleEndian.Uint32(lb[12:16]),
+			Fraction:   binary.LittleEndian.Uint32(lb[16:20]),
+			PlayCount:  binary.LittleEndian.Uint32(lb[20:24]),
+		}
+	}
+	if samplerDataSize != 0 {
+		offset := smplFixedSize + sampleLoopSize*int(loops)
+		c.SamplerData = make([]byte, samplerDataSize)
+		copy(c.SamplerData, b[offset:])
+	}
+
+	return n, nil
+}
@@ -0,0 +1,176 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestSamplerChunk(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		chunk *wavebin.SamplerChunk
+		size  uint32
+	}{
+		{
+			name: "NoLoops",
+			chunk: &wavebin.SamplerChunk{
+				SamplePeriod:  22675,
+				MIDIUnityNote: 60,
+				Loops:         []wavebin.SampleLoop{},
+			},
+			size: 36,
+		},
+		{
+			name: "Loops",
+			chunk: &wavebin.SamplerChunk{
+				Manufacturer:      0x01000041,
+				Product:           1,
+				SamplePeriod:      20833,
+				MIDIUnityNote:     69,
+				MIDIPitchFraction: 0x80000000,
+				SMPTEFormat:       25,
+				SMPTEOffset:       0x01020304,
+				Loops: []wavebin.SampleLoop{
+					{CuePointID: 1, Type: wavebin.SampleLoopForward, Start: 100, End: 4000},
+					{CuePointID: 2, Type: wavebin.SampleLoopAlternating, Start: 4000, End: 8000, Fraction: 1, PlayCount: 2},
+				},
+				SamplerData: []byte{0x01, 0x02, 0x03},
+			},
+			size: 36 + 24*2 + 3,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := tt.chunk.Chunk().(*riffbin.OnMemorySubChunk)
+			if string(chunk.ChunkID()) != "smpl" {
+				t.Errorf("unexpected chunk ID: %s", string(chunk.ChunkID()))
+			}
+			if chunk.BodySize() != tt.size {
+				t.Errorf("unexpected body size: %d", chunk.BodySize())
+			}
+
+			var got wavebin.SamplerChunk
+			_, err := got.ReadFrom(bytes.NewReader(chunk.Payload))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if df := cmp.Diff(tt.chunk, &got); df != "" {
+				t.Errorf("smpl diff: %s", df)
+			}
+		})
+	}
+}
+
+func TestInstrumentChunk(t *testing.T) {
+	instChunk := &wavebin.InstrumentChunk{
+		UnshiftedNote: 60,
+		FineTune:      -50,
+		Gain:          -6,
+		LowNote:       48,
+		HighNote:      72,
+		LowVelocity:   1,
+		HighVelocity:  127,
+	}
+	smplChunk := &wavebin.SamplerChunk{
+		MIDIUnityNote: 60,
+		Loops: []wavebin.SampleLoop{
+			{Type: wavebin.SampleLoopForward, Start: 0, End: 3},
+		},
+	}
+
+	chunk := instChunk.Chunk().(*riffbin.OnMemorySubChunk)
+	if df := cmp.Diff([]byte{60, 0xCE, 0xFA, 48, 72, 1, 127}, chunk.Payload); df != "" {
+		t.Errorf("inst payload diff: %s", df)
+	}
+
+	riffChunk := wavebin.CreateCompletedRIFF(
+		&wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		},
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		smplChunk,
+		instChunk,
+	)
+
+	chunks, err := wavebin.ParseWaveChunks(riffChunk, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if df := cmp.Diff(smplChunk, chunks.Smpl); df != "" {
+		t.Errorf("smpl diff: %s", df)
+	}
+	if df := cmp.Diff(instChunk, chunks.Inst); df != "" {
+		t.Errorf("inst diff: %s", df)
+	}
+
+	t.Run("PadByte", func(t *testing.T) {
+		format := &wavebin.ExtendedFormatChunk{
+			MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 8),
+		}
+		smplChunk := &wavebin.SamplerChunk{Loops: []wavebin.SampleLoop{}, SamplerData: []byte{0x01, 0x02, 0x03}}
+
+		var buf bytes.Buffer
+		w, err := wavebin.CreateStreamingSampleWriter(&buf, 0, format, instChunk, smplChunk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		// the chunk sizes are the spec sizes and the pad bytes follow the bodies
+		if expected := []byte("inst\x07\x00\x00\x00\x3c\xce\xfa\x30\x48\x01\x7f\x00smpl\x27\x00\x00\x00"); !bytes.Contains(buf.Bytes(), expected) {
+			t.Errorf("unexpected inst chunk: %q", buf.Bytes())
+		}
+		if expected := []byte("\x01\x02\x03\x00data"); !bytes.Contains(buf.Bytes(), expected) {
+			t.Errorf("unexpected smpl chunk: %q", buf.Bytes())
+		}
+
+		decoder, err := wavebin.NewDecoder(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if df := cmp.Diff(instChunk, decoder.Chunks().Inst); df != "" {
+			t.Errorf("inst diff: %s", df)
+		}
+		if df := cmp.Diff(smplChunk, decoder.Chunks().Smpl); df != "" {
+			t.Errorf("smpl diff: %s", df)
+		}
+	})
+
+	t.Run("InvalidSamplerChunk", func(t *testing.T) {
+		payload := (&wavebin.SamplerChunk{Loops: []wavebin.SampleLoop{{}}}).Bytes()
+		_, err := wavebin.ParseWaveChunks(&riffbin.RIFFChunk{
+			FormType: [4]byte{'W', 'A', 'V', 'E'},
+			Payload: []riffbin.Chunk{
+				&riffbin.OnMemorySubChunk{
+					ID:      [4]byte{'s', 'm', 'p', 'l'},
+					Payload: payload[:len(payload)-1],
+				},
+			},
+		}, false)
+		if !errors.Is(err, wavebin.ErrUnexpectedChunkSize) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+
+	t.Run("InvalidInstrumentChunk", func(t *testing.T) {
+		_, err := wavebin.ParseWaveChunks(&riffbin.RIFFChunk{
+			FormType: [4]byte{'W', 'A', 'V', 'E'},
+			Payload: []riffbin.Chunk{
+				&riffbin.OnMemorySubChunk{
+					ID:      [4]byte{'i', 'n', 's', 't'},
+					Payload: []byte{60},
+				},
+			},
+		}, false)
+		if !errors.Is(err, wavebin.ErrUnexpectedChunkSize) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package wavebin
+
+import (
+	"fmt"
+	"io"
+)
+
+// UnknownDataSize is the data size for CreateStreamingSampleWriter to write the placeholder sizes.
+const UnknownDataSize = -1
+
+// CreateStreamingSampleWriter creates io.WriteCloser to write samples to the non-seekable writer such as a pipe or a HTTP response.
+// If dataSize is UnknownDataSize, the RIFF and data chunk sizes are written as 0xFFFFFFFF and ReadWaveRIFF reads such a data chunk until EOF.
+// Otherwise the sizes are computed from dataSize, and it returns ErrDataTooLarge on Write or ErrUnexpectedChunkSize on Close if the samples do not match dataSize.
+// It does not close w on Close.
+func CreateStreamingSampleWriter(w io.Writer, dataSize int64, format FormatChunk, extras ...ChunkProvider) (io.WriteCloser, error) {
+	chunks := waveHeaderChunks(0, format, extras...)
+
+	riffSize, dataSize32 := uint32(rf64PlaceholderSize), uint32(rf64PlaceholderSize)
+	if dataSize != UnknownDataSize {
+		if dataSize < 0 {
+			return nil, fmt.Errorf("%w: negative data size %d", ErrUnexpectedChunkSize, dataSize)
+		}
+
+		size := waveHeaderSize(chunks) + uint64(dataSize)
+		if size > maxRIFFSize {
+			return nil, fmt.Errorf("%w: RIFF chunk exceeds %d bytes", ErrDataTooLarge, maxRIFFSize)
+		}
+		riffSize, dataSize32 = uint32(size), uint32(dataSize)
+	}
+
+	_, err := writeWaveHeaderChunks(w, riffSize, dataSize32, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &streamingSampleWriter{w: w, dataSize: dataSize}, nil
+}
+
+type streamingSampleWriter struct {
+	w        io.Writer
+	dataSize int64
+	written  int64
+	closed   bool
+}
+
+func (w *streamingSampleWriter) Write(data []byte) (int, error) {
+	if w.closed {
+		return 0, io.ErrClosedPipe
+	}
+	if w.dataSize != UnknownDataSize && w.written+int64(len(data)) > w.dataSize {
+		return 0, fmt.Errorf("%w: data chunk exceeds the declared %d bytes", ErrDataTooLarge, w.dataSize)
+	}
+
+	n, err := w.w.Write(data)
+	w.written += int64(n)
+	return n, err
+}
+
+func (w *streamingSampleWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if w.dataSize != UnknownDataSize && w.written != w.dataSize {
+		return fmt.Errorf("RIFF[WAVE].data: %w: %d bytes written but %d bytes declared", ErrUnexpectedChunkSize, w.written, w.dataSize)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/karupanerura/wavebin"
+)
+
+func TestCreateStreamingSampleWriter(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.StereoChannels, 44100, 16),
+	}
+	infoChunk := &wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoArtistIART: "AAA"}}
+	samples := []byte{0x00, 0x80, 0xff, 0x7f, 0x00, 0x40, 0x00, 0x00}
+
+	for _, tt := range []struct {
+		name             string
+		dataSize         int64
+		expectedRIFFSize uint32
+	}{
+		{"UnknownDataSize", wavebin.UnknownDataSize, 0xFFFFFFFF},
+		{"DeclaredDataSize", int64(len(samples)), wavebin.CreateCompletedRIFF(format, samples, infoChunk).BodySize()},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := wavebin.CreateStreamingSampleWriter(&buf, tt.dataSize, format, infoChunk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < len(samples); i += 4 {
+				_, err = w.Write(samples[i : i+4])
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			err = w.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if size := binary.LittleEndian.Uint32(buf.Bytes()[4:8]); size != tt.expectedRIFFSize {
+				t.Errorf("unexpected RIFF size: 0x%08X", size)
+			}
+
+			for _, r := range []io.Reader{bytes.NewReader(buf.Bytes()), io.MultiReader(bytes.NewReader(buf.Bytes()))} {
+				decoder, err := wavebin.NewDecoder(r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if df := cmp.Diff(infoChunk, decoder.Info()); df != "" {
+					t.Errorf("INFO diff: %s", df)
+				}
+
+				got, err := io.ReadAll(decoder.Data())
+				if err != nil {
+					t.Fatal(err)
+				}
+				if df := cmp.Diff(samples, got); df != "" {
+					t.Errorf("samples diff: %s", df)
+				}
+			}
+		})
+	}
+
+	t.Run("TooManySamples", func(t *testing.T) {
+		w, err := wavebin.CreateStreamingSampleWriter(io.Discard, 4, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = w.Write(samples)
+		if !errors.Is(err, wavebin.ErrDataTooLarge) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+
+	t.Run("TooFewSamples", func(t *testing.T) {
+		w, err := wavebin.CreateStreamingSampleWriter(io.Discard, 16, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = w.Write(samples)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = w.Close()
+		if !errors.Is(err, wavebin.ErrUnexpectedChunkSize) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+
+	t.Run("DataTooLarge", func(t *testing.T) {
+		_, err := wavebin.CreateStreamingSampleWriter(io.Discard, 0xFFFFFFFF, format)
+		if !errors.Is(err, wavebin.ErrDataTooLarge) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package wavebin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/karupanerura/riffbin"
+)
+
+// Severity is the severity of the Finding.
+type Severity int
+
+const (
+	// SeverityWarning is the inconsistency that the most of readers can tolerate.
+	SeverityWarning Severity = iota + 1
+
+	// SeverityError is the inconsistency that the samples cannot be read correctly.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// Finding is the inconsistency found by Validate.
+// Err wraps the error such as ErrUnexpectedBlockAlign to be checked by errors.Is.
+type Finding struct {
+	Severity Severity
+	Chunk    string
+	Err      error
+}
+
+func (f *Finding) Error() string {
+	return fmt.Sprintf("%s: %s: %v", f.Severity, f.Chunk, f.Err)
+}
+
+func (f *Finding) Unwrap() error {
+	return f.Err
+}
+
+// Validate validates the structure of the WAVE RIFF chunk and the consistency of its format, and returns the findings.
+// It returns no findings if the RIFF chunk is valid. The RIFF chunk is parsed by ParseWaveChunks, so it cannot be parsed again.
+// The pad bytes cannot be seen in the RIFF chunk, so use ValidateReader to validate them.
+func Validate(riffChunk *riffbin.RIFFChunk) []Finding {
+	return validate(riffChunk, nil)
+}
+
+// ValidateReader reads the WAVE binary from r by ReadWaveRIFF and validates it as well as Validate.
+// In addition, it reports the odd-sized chunks followed by the next chunk without the pad byte.
+// It returns the error only if r cannot be read as a WAVE binary.
+func ValidateReader(r io.Reader) ([]Finding, error) {
+	riffChunk, unpadded, err := readWaveRIFF(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return validate(riffChunk, unpadded), nil
+}
+
+func validate(riffChunk *riffbin.RIFFChunk, unpadded []string) []Finding {
+	findings := validateStructure("RIFF[WAVE]", riffChunk.Payload, true)
+	for _, path := range unpadded {
+		findings = append(findings, Finding{Severity: SeverityWarning, Chunk: path, Err: fmt.Errorf("%w: odd size without pad byte", ErrUnexpectedChunkSize)})
+	}
+
+	chunks, err := ParseWaveChunks(riffChunk, true)
+	if err != nil {
+		return append(findings, Finding{Severity: SeverityError, Chunk: "RIFF[WAVE]", Err: err})
+	}
+
+	return append(findings, ValidateChunks(chunks)...)
+}
+
+// ValidateChunks validates the consistency of the parsed chunks as well as Validate, but it does not validate the structure.
+func ValidateChunks(chunks *WaveChunks) []Finding {
+	var findings []Finding
+	report := func(severity Severity, chunk string, err error) {
+		findings = append(findings, Finding{Severity: severity, Chunk: "RIFF[WAVE]." + chunk, Err: err})
+	}
+
+	if chunks.Format == nil {
+		report(SeverityError, "fmt", ErrLackOfRequiredChunks)
+		return findings
+	}
+	if chunks.Data == nil {
+		report(SeverityError, "data", ErrLackOfRequiredChunks)
+	}
+
+	format := chunks.Format
+	blockAlign := uint32(format.BlockAlign())
+	code := CompressionCode(EffectiveCompressionCode(format))
+	uncompressed := code == pcmCompressionCode || code == ieeeFloatCompressionCode
+	if blockAlign == 0 {
+		report(SeverityError, "fmt", fmt.Errorf("%w: zero", ErrUnexpectedBlockAlign))
+	} else if uncompressed {
+		expected := uint32(format.Channels()) * ((uint32(format.SignificantBitsPerSample()) + 7) / 8)
+		if blockAlign != expected {
+			report(SeverityError, "fmt", fmt.Errorf("%w: %d, expected channels * bytes per sample = %d", ErrUnexpectedBlockAlign, blockAlign, expected))
+		}
+		if expected := format.SamplesPerSecond() * blockAlign; format.AverageBytesPerSecond() != expected {
+			report(SeverityWarning, "fmt", fmt.Errorf("%w: average bytes per second %d, expected sample rate * block align = %d", ErrInconsistentFormat, format.AverageBytesPerSecond(), expected))
+		}
+	}
+	if ef := format.ExtraField(); uncompressed && CompressionCode(format.CompressionCode()) == extensibleCompressionCode && len(ef) >= 2 {
+		if validBits := binary.LittleEndian.Uint16(ef[0:2]); validBits > format.SignificantBitsPerSample() {
+			report(SeverityError, "fmt", fmt.Errorf("%w: valid bits per sample %d exceeds bits per sample %d", ErrInconsistentFormat, validBits, format.SignificantBitsPerSample()))
+		}
+	}
+
+	if needsFactChunk(format) && chunks.Fact == nil {
+		report(SeverityWarning, "fact", fmt.Errorf("%w: required for compression code 0x%04X", ErrLackOfRequiredChunks, uint16(code)))
+	}
+
+	if _, incomplete := chunks.Data.(*riffbin.IncompleteSubChunk); chunks.Data == nil || incomplete || blockAlign == 0 {
+		// the data size is unknown
+		return findings
+	}
+	dataSize := uint64(chunks.Data.BodySize())
+	if c, ok := chunks.Data.(*riffbin.InStreamSubChunk); ok {
+		// BodySize is truncated to 32bit for RF64/BW64
+		dataSize = uint64(c.Size())
+	}
+	if dataSize%uint64(blockAlign) != 0 {
+		report(SeverityWarning, "data", fmt.Errorf("%w: %d bytes is not a multiple of block align %d", ErrUnexpectedBlockAlign, dataSize, blockAlign))
+	}
+	if chunks.Fact != nil && uncompressed {
+		if frames := dataSize / uint64(blockAlign); uint64(chunks.Fact.SampleLength) != frames {
+			report(SeverityWarning, "fact", fmt.Errorf("%w: sample length %d, expected %d", ErrInconsistentFormat, chunks.Fact.SampleLength, frames))
+		}
+	}
+
+	return findings
+}
+
+// validateStructure validates the duplication of the chunks.
+func validateStructure(parent string, payload []riffbin.Chunk, top bool) []Finding {
+	var findings []Finding
+	seen := map[[4]byte]bool{}
+	for _, chunk := range payload {
+		var id [4]byte
+		copy(id[:], chunk.ChunkID())
+		path := parent + "." + chunkName(chunk)
+		if top && (id == fmtBytes || id == dataBytes) {
+			if seen[id] {
+				findings = append(findings, Finding{Severity: SeverityError, Chunk: path, Err: ErrDuplicateChunk})
+			}
+			seen[id] = true
+		}
+
+		if c, ok := chunk.(*riffbin.ListChunk); ok {
+			findings = append(findings, validateStructure(path, c.Payload, false)...)
+		}
+	}
+
+	return findings
+}
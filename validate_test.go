@@ -0,0 +1,312 @@
+package wavebin_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/karupanerura/riffbin"
+	"github.com/karupanerura/wavebin"
+)
+
+func newFormatSubChunk(code, channels uint16, samplesPerSecond, averageBytesPerSecond uint32, blockAlign, bitsPerSample uint16) riffbin.Chunk {
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint16(b[0:2], code)
+	binary.LittleEndian.PutUint16(b[2:4], channels)
+	binary.LittleEndian.PutUint32(b[4:8], samplesPerSecond)
+	binary.LittleEndian.PutUint32(b[8:12], averageBytesPerSecond)
+	binary.LittleEndian.PutUint16(b[12:14], blockAlign)
+	binary.LittleEndian.PutUint16(b[14:16], bitsPerSample)
+	return &riffbin.OnMemorySubChunk{ID: [4]byte{'f', 'm', 't', ' '}, Payload: b}
+}
+
+func TestValidate(t *testing.T) {
+	type finding struct {
+		severity wavebin.Severity
+		chunk    string
+		err      error
+	}
+
+	pcm := func() riffbin.Chunk { return newFormatSubChunk(1, 2, 44100, 44100*4, 4, 16) }
+	data := func(size int) riffbin.Chunk {
+		return &riffbin.OnMemorySubChunk{ID: [4]byte{'d', 'a', 't', 'a'}, Payload: make([]byte, size)}
+	}
+	fact := func(sampleLength uint32) riffbin.Chunk {
+		return (&wavebin.FactChunk{SampleLength: wavebin.SampleLength(sampleLength)}).Chunk()
+	}
+
+	for _, tt := range []struct {
+		name     string
+		chunks   func() []riffbin.Chunk
+		expected []finding
+	}{
+		{
+			name:     "Valid",
+			chunks:   func() []riffbin.Chunk { return []riffbin.Chunk{pcm(), data(8)} },
+			expected: nil,
+		},
+		{
+			name: "BlockAlign",
+			chunks: func() []riffbin.Chunk {
+				return []riffbin.Chunk{newFormatSubChunk(1, 2, 44100, 44100*6, 6, 16), data(12)}
+			},
+			expected: []finding{
+				{wavebin.SeverityError, "RIFF[WAVE].fmt", wavebin.ErrUnexpectedBlockAlign},
+			},
+		},
+		{
+			name: "ZeroBlockAlign",
+			chunks: func() []riffbin.Chunk {
+				return []riffbin.Chunk{newFormatSubChunk(1, 2, 44100, 0, 0, 16), data(8)}
+			},
+			expected: []finding{
+				{wavebin.SeverityError, "RIFF[WAVE].fmt", wavebin.ErrUnexpectedBlockAlign},
+			},
+		},
+		{
+			name: "AverageBytesPerSecond",
+			chunks: func() []riffbin.Chunk {
+				return []riffbin.Chunk{newFormatSubChunk(1, 2, 44100, 44100*2, 4, 16), data(8)}
+			},
+			expected: []finding{
+				{wavebin.SeverityWarning, "RIFF[WAVE].fmt", wavebin.ErrInconsistentFormat},
+			},
+		},
+		{
+			name:   "DataSize",
+			chunks: func() []riffbin.Chunk { return []riffbin.Chunk{pcm(), data(6)} },
+			expected: []finding{
+				{wavebin.SeverityWarning, "RIFF[WAVE].data", wavebin.ErrUnexpectedBlockAlign},
+			},
+		},
+		{
+			name: "MissingFact",
+			chunks: func() []riffbin.Chunk {
+				return []riffbin.Chunk{newFormatSubChunk(3, 2, 44100, 44100*8, 8, 32), data(16)}
+			},
+			expected: []finding{
+				{wavebin.SeverityWarning, "RIFF[WAVE].fact", wavebin.ErrLackOfRequiredChunks},
+			},
+		},
+		{
+			name: "FactSampleLength",
+			chunks: func() []riffbin.Chunk {
+				return []riffbin.Chunk{newFormatSubChunk(3, 2, 44100, 44100*8, 8, 32), fact(3), data(16)}
+			},
+			expected: []finding{
+				{wavebin.SeverityWarning, "RIFF[WAVE].fact", wavebin.ErrInconsistentFormat},
+			},
+		},
+		{
+			name: "OddSize",
+			chunks: func() []riffbin.Chunk {
+				return []riffbin.Chunk{
+					pcm(),
+					&riffbin.ListChunk{ListType: [4]byte{'I', 'N', 'F', 'O'}, Payload: []riffbin.Chunk{
+						&riffbin.OnMemorySubChunk{ID: [4]byte{'I', 'A', 'R', 'T'}, Payload: []byte("AAA")},
+					}},
+					data(8),
+				}
+			},
+			expected: nil, // the pad bytes are validated by ValidateReader
+		},
+		{
+			name:   "DuplicateData",
+			chunks: func() []riffbin.Chunk { return []riffbin.Chunk{pcm(), data(8), data(8)} },
+			expected: []finding{
+				{wavebin.SeverityError, "RIFF[WAVE].data", wavebin.ErrDuplicateChunk},
+			},
+		},
+		{
+			name:   "MissingFormat",
+			chunks: func() []riffbin.Chunk { return []riffbin.Chunk{data(8)} },
+			expected: []finding{
+				{wavebin.SeverityError, "RIFF[WAVE]", wavebin.ErrLackOfRequiredChunks},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			findings := wavebin.Validate(&riffbin.RIFFChunk{FormType: [4]byte{'W', 'A', 'V', 'E'}, Payload: tt.chunks()})
+			if len(findings) != len(tt.expected) {
+				t.Fatalf("unexpected findings: %v", findings)
+			}
+			for i, expected := range tt.expected {
+				got := findings[i]
+				if got.Severity != expected.severity || got.Chunk != expected.chunk || !errors.Is(&got, expected.err) {
+					t.Errorf("unexpected finding: %v", &got)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_RF64(t *testing.T) {
+	defer wavebin.SetMaxRIFFSize(64)()
+
+	f, err := os.CreateTemp("", "wavebin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	// more than 4GiB of 32bit float samples
+	const dataSize = 1<<32 + 4
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewIEEEFloatMetaFormat(wavebin.MonoralChannels, 48000, 32),
+	}
+	w, err := wavebin.CreateRF64SampleWriter(f, format, &wavebin.FactChunk{SampleLength: dataSize / 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(make([]byte, 8)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// grow the data chunk as a sparse file
+	stat, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ds64 [24]byte
+	if _, err := f.ReadAt(ds64[:], 20); err != nil {
+		t.Fatal(err)
+	}
+	binary.LittleEndian.PutUint64(ds64[0:8], binary.LittleEndian.Uint64(ds64[0:8])+dataSize-8)
+	binary.LittleEndian.PutUint64(ds64[8:16], dataSize)
+	binary.LittleEndian.PutUint64(ds64[16:24], dataSize/4)
+	if _, err := f.WriteAt(ds64[:], 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Truncate(stat.Size() + dataSize - 8); err != nil {
+		t.Skipf("sparse file is not supported: %v", err)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	riffChunk, err := wavebin.ReadWaveRIFF(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if findings := wavebin.Validate(riffChunk); len(findings) != 0 {
+		t.Errorf("unexpected findings: %v", findings)
+	}
+}
+
+func TestValidateReader(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 16),
+	}
+	extras := []wavebin.ChunkProvider{
+		&wavebin.RawChunk{ID: [4]byte{'o', 'd', 'd', ' '}, Payload: []byte{0x01, 0x02, 0x03}},
+		&wavebin.RawListChunk{
+			ListType: [4]byte{'v', 'n', 'd', 'r'},
+			Payload: []wavebin.ChunkProvider{
+				&wavebin.RawChunk{ID: [4]byte{'a', 'b', 'c', 'd'}, Payload: []byte{0x01}},
+				&wavebin.RawChunk{ID: [4]byte{'e', 'f', 'g', 'h'}, Payload: []byte{0x01, 0x02}},
+			},
+		},
+	}
+	samples := []byte{0x00, 0x00}
+
+	t.Run("Padded", func(t *testing.T) {
+		var buf bytes.Buffer
+		w, err := wavebin.CreateStreamingSampleWriter(&buf, int64(len(samples)), format, extras...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(samples); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		findings, err := wavebin.ValidateReader(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(findings) != 0 {
+			t.Errorf("unexpected findings: %v", findings)
+		}
+	})
+
+	t.Run("Unpadded", func(t *testing.T) {
+		// riffbin.CompletedChunkWriter does not write the pad bytes
+		var buf bytes.Buffer
+		_, err := riffbin.NewCompletedChunkWriter(&buf).Write(wavebin.CreateCompletedRIFF(format, samples, extras...))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		findings, err := wavebin.ValidateReader(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(findings) != 3 {
+			t.Fatalf("unexpected findings: %v", findings)
+		}
+		for i, chunk := range []string{"RIFF[WAVE].odd ", "RIFF[WAVE].LIST[vndr].abcd", "RIFF[WAVE].LIST[vndr]"} {
+			got := findings[i]
+			if got.Severity != wavebin.SeverityWarning || got.Chunk != chunk || !errors.Is(&got, wavebin.ErrUnexpectedChunkSize) {
+				t.Errorf("unexpected finding: %v", &got)
+			}
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := wavebin.ValidateReader(bytes.NewReader([]byte("RIFX")))
+		if !errors.Is(err, riffbin.ErrInvalidFormat) {
+			t.Errorf("unexpected err: %v", err)
+		}
+	})
+}
+
+func TestValidate_WrittenChunks(t *testing.T) {
+	format := &wavebin.ExtendedFormatChunk{
+		MetaFormat: wavebin.NewPCMMetaFormat(wavebin.MonoralChannels, 44100, 16),
+	}
+	extras := []wavebin.ChunkProvider{
+		&wavebin.InfoChunk{Data: map[wavebin.InfoKey]string{wavebin.InfoTitleINAM: "odd"}},
+		&wavebin.CharacterSetChunk{CodePage: wavebin.CodePageUTF8},
+		&wavebin.BextChunk{Description: "odd", CodingHistory: "A=PCM\r\n"},
+		&wavebin.CartChunk{Title: "odd", TagText: "odd"},
+		&wavebin.IXMLChunk{Document: wavebin.IXMLDocument{Scene: "1"}},
+		&wavebin.CueChunk{Points: []wavebin.CuePoint{{ID: 1, DataChunkID: [4]byte{'d', 'a', 't', 'a'}}}},
+		&wavebin.AssociatedDataListChunk{
+			Labels:       []wavebin.CueLabel{{CuePointID: 1, Text: "od"}},
+			Notes:        []wavebin.CueNote{{CuePointID: 1, Text: "odd"}},
+			LabeledTexts: []wavebin.CueLabeledText{{CuePointID: 1, Text: "od"}},
+		},
+		&wavebin.SamplerChunk{SamplerData: []byte{0x01}},
+		&wavebin.InstrumentChunk{UnshiftedNote: 60},
+	}
+
+	var buf bytes.Buffer
+	w, err := wavebin.CreateStreamingSampleWriter(&buf, 2, format, extras...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte{0x00, 0x00}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	findings, err := wavebin.ValidateReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 0 {
+		t.Errorf("unexpected findings: %v", findings)
+	}
+}